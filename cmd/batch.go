@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/felipemarinho97/deezer-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var readStdin bool
+
+// batchStatus tracks failures across a batch so that one bad ID is
+// reported without aborting the rest.
+type batchStatus struct {
+	failed   int
+	total    int
+	firstErr error
+}
+
+func (b *batchStatus) fail(err error) {
+	b.failed++
+	if b.firstErr == nil {
+		b.firstErr = err
+	}
+}
+
+// code is the exit code of the batch: exitOK when nothing failed, the code
+// of the error when every item failed, or exitPartial when only some did.
+func (b *batchStatus) code() int {
+	if b.failed == 0 {
+		return exitOK
+	}
+	if b.failed >= b.total {
+		return exitCode(b.firstErr)
+	}
+	return exitPartial
+}
+
+// exit ends the process with the batch's exit code when items failed.
+func (b *batchStatus) exit() {
+	if code := b.code(); code != exitOK {
+		os.Exit(code)
+	}
+}
+
+// isBatch reports whether IDs should be read from stdin, requested with
+// --stdin or with "-" in place of the ID.
+func isBatch(args []string) bool {
+	return readStdin || (len(args) == 2 && args[1] == "-")
+}
+
+func addStdinFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&readStdin, "stdin", false, "Read newline-separated IDs, Deezer URLs or JSON lines from stdin")
+}
+
+// parseIDArg parses the ID argument of a [type] [id] command, which may
+// also be a Deezer URL of that type.
+func parseIDArg(ctx context.Context, client *api.Client, args []string) int64 {
+	if len(args) != 2 {
+		fmt.Fprintf(os.Stderr, "Missing ID: pass an ID, or - / --stdin to read IDs from stdin\n")
+		os.Exit(exitUsage)
+	}
+
+	return parseID(ctx, client, args[0], args[1])
+}
+
+// parseID parses an ID or Deezer URL of itemType, exiting on failure.
+func parseID(ctx context.Context, client *api.Client, itemType string, arg string) int64 {
+	id, err := resolveID(ctx, client, itemType, arg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid ID: %v\n", err)
+		code := exitCode(err)
+		if code == exitError {
+			code = exitUsage
+		}
+		os.Exit(code)
+	}
+
+	return id
+}
+
+// readIDs reads one ID per line. Lines may hold a numeric ID, a Deezer URL
+// of itemType or a JSON object with an "id" field; blank lines and lines
+// starting with # are skipped and unparsable lines are reported as failures.
+func readIDs(ctx context.Context, client *api.Client, itemType string, r io.Reader, status *batchStatus) []int64 {
+	var ids []int64
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		status.total++
+		id, err := parseIDLine(ctx, client, itemType, line)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping line %d: %v\n", lineNumber, err)
+			status.fail(err)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading stdin: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	return ids
+}
+
+func parseIDLine(ctx context.Context, client *api.Client, itemType string, line string) (int64, error) {
+	if strings.HasPrefix(line, "{") {
+		var item struct {
+			ID json.Number `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			return 0, fmt.Errorf("invalid JSON: %w", err)
+		}
+		if item.ID == "" {
+			return 0, fmt.Errorf("JSON line has no \"id\" field")
+		}
+		return strconv.ParseInt(item.ID.String(), 10, 64)
+	}
+
+	return resolveID(ctx, client, itemType, line)
+}
+
+// collectBatch reports failed results on stderr and returns the items that
+// were fetched, in input order.
+func collectBatch[T any](results []api.BatchResult[T], kind string, status *batchStatus) []T {
+	var items []T
+
+	for _, result := range results {
+		if result.Err != nil {
+			ref := strconv.FormatInt(result.ID, 10)
+			if result.Key != "" {
+				ref = result.Key
+			}
+			fmt.Fprintf(os.Stderr, "Error getting %s %s: %v\n", kind, ref, result.Err)
+			status.fail(result.Err)
+			continue
+		}
+		items = append(items, *result.Item)
+	}
+
+	return items
+}
+
+func getBatch(ctx context.Context, client *api.Client, itemType string, formatter *output.Formatter) {
+	status := &batchStatus{}
+	ids := readIDs(ctx, client, itemType, os.Stdin, status)
+
+	switch itemType {
+	case "track":
+		formatter.FormatTracks(collectBatch(client.GetTracks(ctx, ids), "track", status))
+	case "album":
+		formatter.FormatAlbums(collectBatch(client.GetAlbums(ctx, ids), "album", status))
+	case "artist":
+		formatter.FormatArtists(collectBatch(client.GetArtists(ctx, ids), "artist", status))
+	case "playlist":
+		formatter.FormatPlaylists(collectBatch(client.GetPlaylists(ctx, ids), "playlist", status))
+	case "show", "podcast":
+		formatter.FormatShows(collectBatch(client.GetShows(ctx, ids), "show", status))
+	case "episode":
+		formatter.FormatEpisodes(collectBatch(client.GetEpisodes(ctx, ids), "episode", status))
+	case "user":
+		formatter.FormatUsers(collectBatch(client.GetUsers(ctx, ids), "user", status))
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown type: %s. Use track, album, artist, playlist, show, episode, or user\n", itemType)
+		os.Exit(exitUsage)
+	}
+
+	status.exit()
+}
+
+func getTracksBatch(ctx context.Context, client *api.Client, itemType string, formatter *output.Formatter) {
+	var fetch func(ctx context.Context, id int64) (*api.TracksResult, error)
+
+	switch itemType {
+	case "album":
+		fetch = func(ctx context.Context, id int64) (*api.TracksResult, error) {
+			return client.GetAlbumTracksContext(ctx, id, limit)
+		}
+	case "playlist":
+		fetch = func(ctx context.Context, id int64) (*api.TracksResult, error) {
+			return client.GetPlaylistTracksContext(ctx, id, limit)
+		}
+	case "artist":
+		fetch = func(ctx context.Context, id int64) (*api.TracksResult, error) {
+			return client.GetArtistTopTracksContext(ctx, id, limit)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown type: %s. Use album, playlist, or artist\n", itemType)
+		os.Exit(exitUsage)
+	}
+
+	status := &batchStatus{}
+	ids := readIDs(ctx, client, itemType, os.Stdin, status)
+
+	var tracks []api.Track
+	for _, result := range collectBatch(api.Batch(ctx, client, ids, fetch), itemType+" tracks for", status) {
+		tracks = append(tracks, result.Data...)
+	}
+
+	formatter.FormatTracks(tracks)
+	status.exit()
+}
+
+func getArtistAlbumsBatch(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	status := &batchStatus{}
+	ids := readIDs(ctx, client, "artist", os.Stdin, status)
+
+	results := api.Batch(ctx, client, ids, func(ctx context.Context, id int64) (*api.AlbumsResult, error) {
+		return client.GetArtistAlbumsContext(ctx, id, limit)
+	})
+
+	var albums []api.Album
+	for _, result := range collectBatch(results, "albums for artist", status) {
+		albums = append(albums, result.Data...)
+	}
+
+	resolveAlbumGenres(ctx, client, albums)
+	formatter.FormatAlbums(albums)
+	status.exit()
+}
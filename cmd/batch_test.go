@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+)
+
+func TestReadIDs(t *testing.T) {
+	client, err := api.NewClientWithOptions(api.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		want   []int64
+		total  int
+		failed int
+	}{
+		{
+			name:  "ids",
+			input: "3135556\n 67238735 \n",
+			want:  []int64{3135556, 67238735},
+			total: 2,
+		},
+		{
+			name:  "blank lines and comments",
+			input: "# tracks\n\n3135556\n\n# more\n67238735\n",
+			want:  []int64{3135556, 67238735},
+			total: 2,
+		},
+		{
+			name:  "urls",
+			input: "https://www.deezer.com/en/track/3135556\ndeezer.com/us/track/67238735?utm_source=x\n",
+			want:  []int64{3135556, 67238735},
+			total: 2,
+		},
+		{
+			name:  "json lines",
+			input: `{"id": 3135556, "title": "Harder, Better, Faster, Stronger"}` + "\n" + `{"id": "67238735"}` + "\n",
+			want:  []int64{3135556, 67238735},
+			total: 2,
+		},
+		{
+			name:   "invalid lines are skipped",
+			input:  "3135556\nabc\n{\"title\": \"no id\"}\n{broken\nhttps://www.deezer.com/album/302127\n67238735\n",
+			want:   []int64{3135556, 67238735},
+			total:  6,
+			failed: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &batchStatus{}
+			ids := readIDs(context.Background(), client, "track", strings.NewReader(tt.input), status)
+
+			if fmt.Sprint(ids) != fmt.Sprint(tt.want) {
+				t.Errorf("ids = %v, want %v", ids, tt.want)
+			}
+			if status.total != tt.total || status.failed != tt.failed {
+				t.Errorf("status = %d of %d failed, want %d of %d", status.failed, status.total, tt.failed, tt.total)
+			}
+		})
+	}
+}
+
+func TestBatchStatusCode(t *testing.T) {
+	notFound := &api.Error{Code: api.CodeDataNotFound}
+	invalid := errors.New("invalid ID")
+
+	tests := []struct {
+		name   string
+		total  int
+		errors []error
+		want   int
+	}{
+		{name: "all succeeded", total: 3, want: exitOK},
+		{name: "empty", total: 0, want: exitOK},
+		{name: "some failed", total: 3, errors: []error{notFound}, want: exitPartial},
+		{name: "invalid line in batch", total: 3, errors: []error{invalid}, want: exitPartial},
+		{name: "all failed", total: 2, errors: []error{notFound, invalid}, want: exitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &batchStatus{total: tt.total}
+			for _, err := range tt.errors {
+				status.fail(err)
+			}
+			if got := status.code(); got != tt.want {
+				t.Errorf("code = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/felipemarinho97/deezer-cli/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and manage the response cache",
+	Long: `Inspect and manage the on-disk API response cache.
+
+Cache keys have the form "endpoint?params", for example "/album/302127?".
+	
+Examples:
+  deezer-cli cache stats
+  deezer-cli cache list --output json
+  deezer-cli cache get "/album/302127?"
+  deezer-cli cache purge /playlist/908622995
+  deezer-cli cache prune`,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show entry count, size, hit ratio and age of cached responses",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, err := openCache().Stats()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading cache: %v\n", err)
+			os.Exit(1)
+		}
+
+		newFormatter().FormatCacheStats(stats)
+	},
+}
+
+var cacheListCmd = &cobra.Command{
+	Use:   "list [prefix]",
+	Short: "List cached responses, optionally filtered by key prefix",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		entries, err := openCache().Entries()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading cache: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(args) == 1 {
+			entries = filterCacheEntries(entries, args[0])
+		}
+
+		newFormatter().FormatCacheEntries(entries)
+	},
+}
+
+var cacheGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "Show a cached response by key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, found := openCache().Lookup(args[0])
+		if !found {
+			fmt.Fprintf(os.Stderr, "No cache entry for key: %s\n", args[0])
+			os.Exit(1)
+		}
+
+		newFormatter().FormatCacheEntry(entry)
+	},
+}
+
+var cachePurgeCmd = &cobra.Command{
+	Use:   "purge [prefix]",
+	Short: "Remove cached responses, optionally only those under an endpoint prefix",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := ""
+		if len(args) == 1 {
+			prefix = args[0]
+		}
+
+		removed, err := openCache().Purge(prefix)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error purging cache: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Removed %d cache entries\n", removed.Removed)
+		if removed.Corrupt > 0 {
+			fmt.Printf("Removed %d corrupt cache files\n", removed.Corrupt)
+		}
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove expired cached responses",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		removed, err := openCache().Prune()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error pruning cache: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Removed %d expired cache entries\n", removed.Removed)
+		if removed.Corrupt > 0 {
+			fmt.Printf("Removed %d corrupt cache files\n", removed.Corrupt)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cacheListCmd)
+	cacheCmd.AddCommand(cacheGetCmd)
+	cacheCmd.AddCommand(cachePurgeCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+}
+
+func openCache() *cache.Cache {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error locating cache directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	c, err := cache.NewDisk(dir, time.Duration(cacheTTL)*time.Second)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening cache: %v\n", err)
+		os.Exit(1)
+	}
+
+	return c
+}
+
+func filterCacheEntries(entries []cache.Entry, prefix string) []cache.Entry {
+	var filtered []cache.Entry
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Key, prefix) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
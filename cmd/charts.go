@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/felipemarinho97/deezer-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var chartGenre int64
+
+var chartsCmd = &cobra.Command{
+	Use:   "charts [tracks|albums|artists|playlists|podcasts]",
+	Short: "Get the top charts, overall or for a genre",
+	Long: `Get the current Deezer top charts for tracks, albums, artists, playlists or podcasts.
+Charts are overall unless a genre ID is given with --genre.
+	
+Examples:
+  deezer-cli charts
+  deezer-cli charts albums --limit 10
+  deezer-cli charts artists --genre 132 --output json
+  deezer-cli charts podcasts --ids-only`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		chartType := "tracks"
+		if len(args) == 1 {
+			chartType = strings.ToLower(args[0])
+		}
+
+		ctx := cmd.Context()
+		client := newClient()
+		formatter := newFormatter()
+
+		switch chartType {
+		case "track", "tracks":
+			getChartTracks(ctx, client, formatter)
+		case "album", "albums":
+			getChartAlbums(ctx, client, formatter)
+		case "artist", "artists":
+			getChartArtists(ctx, client, formatter)
+		case "playlist", "playlists":
+			getChartPlaylists(ctx, client, formatter)
+		case "podcast", "podcasts", "show", "shows":
+			getChartPodcasts(ctx, client, formatter)
+		default:
+			fmt.Fprintf(os.Stderr, "Unknown chart: %s. Use tracks, albums, artists, playlists, or podcasts\n", chartType)
+			os.Exit(exitUsage)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(chartsCmd)
+	chartsCmd.Flags().Int64Var(&chartGenre, "genre", api.ChartAllGenres, "Genre ID to get the charts of (0 for all genres)")
+}
+
+func getChartTracks(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	result, err := client.GetChartTracksContext(ctx, chartGenre, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting chart tracks: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatTracks(result.Data)
+}
+
+func getChartAlbums(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	result, err := client.GetChartAlbumsContext(ctx, chartGenre, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting chart albums: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	resolveAlbumGenres(ctx, client, result.Data)
+	formatter.FormatAlbums(result.Data)
+}
+
+func getChartArtists(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	result, err := client.GetChartArtistsContext(ctx, chartGenre, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting chart artists: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatArtists(result.Data)
+}
+
+func getChartPlaylists(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	result, err := client.GetChartPlaylistsContext(ctx, chartGenre, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting chart playlists: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatPlaylists(result.Data)
+}
+
+func getChartPodcasts(ctx context.Context, client *api.Client, formatter *output.Formatter) {
+	result, err := client.GetChartPodcastsContext(ctx, chartGenre, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting chart podcasts: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatShows(result.Data)
+}
@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/felipemarinho97/deezer-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "View, change and validate the configuration file",
+	Long: `View, change and validate the configuration file.
+	
+Examples:
+  deezer-cli config list
+  deezer-cli config get default_format
+  deezer-cli config set default_format json
+  deezer-cli config unset cache_ttl_seconds
+  deezer-cli config set default_format json --profile ci
+  deezer-cli config list --profile ci
+  deezer-cli config path
+  deezer-cli config edit
+  deezer-cli config validate`,
+	// The config commands must keep working when the file is invalid, so
+	// they skip loading it as flag defaults.
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "Print the value of a configuration key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var value string
+		var err error
+
+		cfg := loadConfig()
+		if profileName != "" {
+			var profile config.Profile
+			profile, err = cfg.Profile(profileName)
+			if err == nil {
+				var set bool
+				value, set, err = profile.Get(args[0])
+				if err == nil && !set {
+					err = fmt.Errorf("%s is not set in profile %q", args[0], profileName)
+				}
+			}
+		} else {
+			value, err = cfg.Get(args[0])
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println(value)
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set [key] [value]",
+	Short: "Set a configuration key",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadConfig()
+		err := updateConfig(cfg, func(profile *config.Profile) error {
+			return profile.Set(args[0], args[1])
+		}, func() error {
+			return cfg.Set(args[0], args[1])
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		saveConfig(cfg)
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset [key]",
+	Short: "Reset a configuration key to its default",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadConfig()
+		if profileName != "" {
+			if _, err := cfg.Profile(profileName); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		err := updateConfig(cfg, func(profile *config.Profile) error {
+			return profile.Unset(args[0])
+		}, func() error {
+			return cfg.Unset(args[0])
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		saveConfig(cfg)
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all configuration keys and values",
+	Long: `List all configuration keys and values. With --profile, the values
+shown are those in effect when that profile is selected.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadConfig()
+		if profileName != "" {
+			if err := cfg.ApplyProfile(profileName); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		newFormatter().FormatConfig(cfg)
+	},
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the configuration file path",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println(config.Path())
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the configuration file in $VISUAL or $EDITOR",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if _, err := os.Stat(config.Path()); os.IsNotExist(err) {
+			if err := config.Save(config.Default()); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		editor := os.Getenv("VISUAL")
+		if editor == "" {
+			editor = os.Getenv("EDITOR")
+		}
+		if editor == "" {
+			editor = "vi"
+		}
+
+		edit := exec.Command(editor, config.Path())
+		edit.Stdin = os.Stdin
+		edit.Stdout = os.Stdout
+		edit.Stderr = os.Stderr
+		if err := edit.Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running editor: %v\n", err)
+			os.Exit(1)
+		}
+
+		if err := config.Check(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration file for errors",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.Check(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("%s is valid\n", config.Path())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configValidateCmd)
+}
+
+func loadConfig() *config.Config {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Fix it with: deezer-cli config edit\n")
+		os.Exit(1)
+	}
+
+	return cfg
+}
+
+// updateConfig applies a change to the profile selected with --profile,
+// creating it when needed, or to the top-level settings otherwise.
+func updateConfig(cfg *config.Config, updateProfile func(profile *config.Profile) error, updateTop func() error) error {
+	if profileName == "" {
+		return updateTop()
+	}
+
+	profile := cfg.Profiles[profileName]
+	if err := updateProfile(&profile); err != nil {
+		return err
+	}
+
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]config.Profile{}
+	}
+	cfg.Profiles[profileName] = profile
+
+	return nil
+}
+
+func saveConfig(cfg *config.Config) {
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := config.Save(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+)
+
+// Process exit codes, documented in COMMAND_REFERENCE.md so scripts can
+// tell failure causes apart.
+const (
+	exitOK           = 0
+	exitError        = 1
+	exitUsage        = 2
+	exitNotFound     = 3
+	exitQuota        = 4
+	exitInvalidParam = 5
+	exitNetwork      = 6
+	exitAPI          = 7
+	exitPartial      = 8
+	exitInterrupted  = 130
+)
+
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	case errors.Is(err, api.ErrNotDeezerURL):
+		return exitUsage
+	case api.IsNotFound(err):
+		return exitNotFound
+	case api.IsQuota(err):
+		return exitQuota
+	case api.IsInvalidParam(err):
+		return exitInvalidParam
+	case api.IsNetwork(err):
+		return exitNetwork
+	case api.IsAPIError(err):
+		return exitAPI
+	default:
+		return exitError
+	}
+}
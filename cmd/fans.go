@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var fansCmd = &cobra.Command{
+	Use:   "fans artist [id]",
+	Short: "Get the fans of an artist",
+	Long: `Get the users who follow a specific artist.
+	
+Examples:
+  deezer-cli fans artist 27
+  deezer-cli fans artist 27 --all --ids-only`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if args[0] != "artist" || len(args) != 2 {
+			fmt.Fprintf(os.Stderr, "Use: deezer-cli fans artist [id]\n")
+			os.Exit(exitUsage)
+		}
+
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseIDArg(ctx, client, args)
+
+		result, err := client.GetArtistFansContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting artist fans: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatUsers(result.Data)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(fansCmd)
+}
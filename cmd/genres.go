@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var genresCmd = &cobra.Command{
+	Use:   "genres",
+	Short: "Browse music genres and their artists and radios",
+	Long: `Browse Deezer's music genres. Genre IDs can be used with "charts --genre".
+	
+Examples:
+  deezer-cli genres list
+  deezer-cli genres get 132
+  deezer-cli genres artists 132 --limit 10
+  deezer-cli genres radios 132 --output json`,
+}
+
+var genresListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all genres",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		result, err := newClient().GetGenresContext(cmd.Context(), limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting genres: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatGenres(result.Data)
+	},
+}
+
+var genresGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get details for a genre",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "genre", args[0])
+
+		genre, err := client.GetGenreContext(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting genre: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatGenre(genre)
+	},
+}
+
+var genresArtistsCmd = &cobra.Command{
+	Use:   "artists [id]",
+	Short: "Get the artists of a genre",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "genre", args[0])
+
+		result, err := client.GetGenreArtistsContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting genre artists: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatArtists(result.Data)
+	},
+}
+
+var genresRadiosCmd = &cobra.Command{
+	Use:   "radios [id]",
+	Short: "Get the radios of a genre",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "genre", args[0])
+
+		result, err := client.GetGenreRadiosContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting genre radios: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatRadios(result.Data)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(genresCmd)
+	genresCmd.AddCommand(genresListCmd)
+	genresCmd.AddCommand(genresGetCmd)
+	genresCmd.AddCommand(genresArtistsCmd)
+	genresCmd.AddCommand(genresRadiosCmd)
+}
+
+// resolveAlbumGenres fills in the genre names of listed albums, which Deezer
+// only returns as a genre ID, when the output shows them: the Genres column
+// of CSV output or of a table selected with --fields genres.
+func resolveAlbumGenres(ctx context.Context, client *api.Client, albums []api.Album) {
+	if !showsAlbumGenres() {
+		return
+	}
+	if err := client.ResolveAlbumGenresContext(ctx, albums); err != nil {
+		// The genre names are a nicety; show the albums without them.
+		fmt.Fprintf(os.Stderr, "Warning: could not resolve genres: %v\n", err)
+	}
+}
+
+func showsAlbumGenres() bool {
+	if idsOnly {
+		return false
+	}
+
+	switch outputFormat {
+	case "csv":
+		return true
+	case "table":
+		for _, field := range fields {
+			if strings.EqualFold(field, "genres") {
+				return true
+			}
+		}
+	}
+	return false
+}
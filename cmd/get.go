@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -11,78 +13,118 @@ import (
 )
 
 var getCmd = &cobra.Command{
-	Use:   "get [type] [id]",
-	Short: "Get details for a specific item by ID",
-	Long: `Get detailed information for a track, album, artist, playlist, show, or episode by its ID.
+	Use:   "get [type] [id] | get [url]",
+	Short: "Get details for a specific item by ID or URL",
+	Long: `Get detailed information for a track, album, artist, playlist, show, episode, or user by its ID
+or by any Deezer URL, including deezer.page.link share links.
 	
 Examples:
   deezer-cli get track 3135556
+  deezer-cli get https://www.deezer.com/en/track/3135556
+  deezer-cli get track --isrc USQX91300108
+  deezer-cli get album --upc 724384960650
+  deezer-cli get track --isrc-file isrcs.txt --output csv
   deezer-cli get album 302127 --output json
   deezer-cli get artist 27 --ids-only
+  deezer-cli get artist 27 --graph graphml --depth 2 > daft-punk.graphml
   deezer-cli get playlist 908622995
   deezer-cli get show 123456
-  deezer-cli get episode 789012`,
-	Args: cobra.ExactArgs(2),
+  deezer-cli get episode 789012
+  deezer-cli get user 5
+  deezer-cli search "daft punk" --type track --ids-only | deezer-cli get track - --output json`,
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
 		itemType := args[0]
-		id, err := strconv.ParseInt(args[1], 10, 64)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Invalid ID: %v\n", err)
-			os.Exit(1)
+		ctx := cmd.Context()
+
+		if relatedGraph == "" && (cmd.Flags().Changed("depth") || cmd.Flags().Changed("max-nodes")) {
+			fmt.Fprintf(os.Stderr, "--depth and --max-nodes require --graph\n")
+			os.Exit(exitUsage)
+		}
+
+		if isCodeLookup() {
+			getByCode(ctx, newClient(), itemType, newFormatter())
+			return
 		}
 
-		client := api.NewClient()
-		formatter := output.NewFormatter(outputFormat, idsOnly, fields)
+		if isBatch(args) {
+			getBatch(ctx, newClient(), itemType, newFormatter())
+			return
+		}
+
+		client := newClient()
+		formatter := newFormatter()
+
+		var id int64
+		if len(args) == 1 && api.IsURL(args[0]) {
+			resource := resolveArg(ctx, client, args[0])
+			itemType, id = resource.Type, resource.ID
+		} else {
+			id = parseIDArg(ctx, client, args)
+		}
+
+		if relatedGraph != "" && itemType != "artist" {
+			fmt.Fprintf(os.Stderr, "--graph is only supported for artists\n")
+			os.Exit(exitUsage)
+		}
 
 		switch itemType {
 		case "track":
-			getTrack(client, id, formatter)
+			getTrack(ctx, client, id, formatter)
 		case "album":
-			getAlbum(client, id, formatter)
+			getAlbum(ctx, client, id, formatter)
 		case "artist":
-			getArtist(client, id, formatter)
+			getArtist(ctx, client, id, formatter)
 		case "playlist":
-			getPlaylist(client, id, formatter)
+			getPlaylist(ctx, client, id, formatter)
 		case "show", "podcast":
-			getShow(client, id, formatter)
+			getShow(ctx, client, id, formatter)
 		case "episode":
-			getEpisode(client, id, formatter)
+			getEpisode(ctx, client, id, formatter)
+		case "user":
+			getUser(ctx, client, id, formatter)
 		default:
-			fmt.Fprintf(os.Stderr, "Unknown type: %s. Use track, album, artist, playlist, show, or episode\n", itemType)
-			os.Exit(1)
+			fmt.Fprintf(os.Stderr, "Unknown type: %s. Use track, album, artist, playlist, show, episode, or user\n", itemType)
+			os.Exit(exitUsage)
 		}
 	},
 }
 
 var tracksCmd = &cobra.Command{
 	Use:   "tracks [type] [id]",
-	Short: "Get tracks for an album or top tracks for an artist",
-	Long: `Get track listings for albums or top tracks for artists.
+	Short: "Get tracks for an album or playlist, or top tracks for an artist",
+	Long: `Get track listings for albums and playlists, or top tracks for artists.
 	
 Examples:
   deezer-cli tracks album 302127
+  deezer-cli tracks playlist 908622995 --all --ids-only
   deezer-cli tracks artist 27 --limit 10
-  deezer-cli tracks album 302127 --output json --limit 5`,
-	Args: cobra.ExactArgs(2),
+  deezer-cli tracks album 302127 --output json --limit 5
+  cat album_ids.txt | deezer-cli tracks album --stdin --output csv`,
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
 		itemType := args[0]
-		id, err := strconv.ParseInt(args[1], 10, 64)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Invalid ID: %v\n", err)
-			os.Exit(1)
+		ctx := cmd.Context()
+
+		if isBatch(args) {
+			getTracksBatch(ctx, newClient(), itemType, newFormatter())
+			return
 		}
 
-		client := api.NewClient()
-		formatter := output.NewFormatter(outputFormat, idsOnly, fields)
+		client := newClient()
+		id := parseIDArg(ctx, client, args)
+		formatter := newFormatter()
 
 		switch itemType {
 		case "album":
-			getAlbumTracks(client, id, formatter)
+			getAlbumTracks(ctx, client, id, formatter)
+		case "playlist":
+			getPlaylistTracks(ctx, client, id, formatter)
 		case "artist":
-			getArtistTopTracks(client, id, formatter)
+			getArtistTopTracks(ctx, client, id, formatter)
 		default:
-			fmt.Fprintf(os.Stderr, "Unknown type: %s. Use album or artist\n", itemType)
-			os.Exit(1)
+			fmt.Fprintf(os.Stderr, "Unknown type: %s. Use album, playlist, or artist\n", itemType)
+			os.Exit(exitUsage)
 		}
 	},
 }
@@ -94,23 +136,26 @@ var albumsCmd = &cobra.Command{
 	
 Examples:
   deezer-cli albums artist 27
-  deezer-cli albums artist 27 --limit 10 --output json`,
-	Args: cobra.ExactArgs(2),
+  deezer-cli albums artist 27 --limit 10 --output json
+  deezer-cli search "queen" --type artist --ids-only --limit 3 | deezer-cli albums artist -`,
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
 		if args[0] != "artist" {
 			fmt.Fprintf(os.Stderr, "Use: deezer-cli albums artist [id]\n")
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
-		id, err := strconv.ParseInt(args[1], 10, 64)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Invalid ID: %v\n", err)
-			os.Exit(1)
+		ctx := cmd.Context()
+
+		if isBatch(args) {
+			getArtistAlbumsBatch(ctx, newClient(), newFormatter())
+			return
 		}
 
-		client := api.NewClient()
-		formatter := output.NewFormatter(outputFormat, idsOnly, fields)
-		getArtistAlbums(client, id, formatter)
+		client := newClient()
+		id := parseIDArg(ctx, client, args)
+		formatter := newFormatter()
+		getArtistAlbums(ctx, client, id, formatter)
 	},
 }
 
@@ -126,18 +171,19 @@ Examples:
 	Run: func(cmd *cobra.Command, args []string) {
 		if args[0] != "show" {
 			fmt.Fprintf(os.Stderr, "Use: deezer-cli episodes show [id]\n")
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
 		id, err := strconv.ParseInt(args[1], 10, 64)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Invalid ID: %v\n", err)
-			os.Exit(1)
+			os.Exit(exitUsage)
 		}
 
-		client := api.NewClient()
-		formatter := output.NewFormatter(outputFormat, idsOnly, fields)
-		getShowEpisodes(client, id, formatter)
+		ctx := cmd.Context()
+		client := newClient()
+		formatter := newFormatter()
+		getShowEpisodes(ctx, client, id, formatter)
 	},
 }
 
@@ -146,103 +192,176 @@ func init() {
 	rootCmd.AddCommand(tracksCmd)
 	rootCmd.AddCommand(albumsCmd)
 	rootCmd.AddCommand(episodesCmd)
+
+	addStdinFlag(getCmd)
+	addCrawlFlags(getCmd)
+	addStdinFlag(tracksCmd)
+	addStdinFlag(albumsCmd)
 }
 
-func getTrack(client *api.Client, id int64, formatter *output.Formatter) {
-	track, err := client.GetTrack(id)
+func getTrack(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	track, err := client.GetTrackContext(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting track: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatTrack(track)
 }
 
-func getAlbum(client *api.Client, id int64, formatter *output.Formatter) {
-	album, err := client.GetAlbum(id)
+func getAlbum(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	// The tracklist and genre name are only shown by the richer formats.
+	detailed := !idsOnly && outputFormat != "ids"
+
+	fetch := client.GetAlbumContext
+	if detailed {
+		fetch = client.GetAlbumWithTracksContext
+	}
+
+	album, err := fetch(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting album: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+
+	if detailed {
+		if err := client.ResolveAlbumGenreContext(ctx, album); err != nil {
+			// The genre name is a nicety; show the album without it.
+			fmt.Fprintf(os.Stderr, "Warning: could not resolve genre %d: %v\n", album.GenreID, err)
+		}
 	}
 
 	formatter.FormatAlbum(album)
 }
 
-func getArtist(client *api.Client, id int64, formatter *output.Formatter) {
-	artist, err := client.GetArtist(id)
+func getArtist(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	if relatedGraph != "" {
+		checkCrawlFlags()
+		graph := crawlRelatedArtists(ctx, client, id)
+		writeArtistGraph(graph, formatter)
+		if len(graph.Failed) > 0 {
+			os.Exit(exitPartial)
+		}
+		return
+	}
+
+	artist, err := client.GetArtistContext(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting artist: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatArtist(artist)
 }
 
-func getPlaylist(client *api.Client, id int64, formatter *output.Formatter) {
-	playlist, err := client.GetPlaylist(id)
+func getPlaylist(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	playlist, err := client.GetPlaylistContext(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting playlist: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatPlaylist(playlist)
 }
 
-func getShow(client *api.Client, id int64, formatter *output.Formatter) {
-	show, err := client.GetShow(id)
+func getShow(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	show, err := client.GetShowContext(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting show: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatShow(show)
 }
 
-func getEpisode(client *api.Client, id int64, formatter *output.Formatter) {
-	episode, err := client.GetEpisode(id)
+func getEpisode(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	episode, err := client.GetEpisodeContext(ctx, id)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting episode: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatEpisode(episode)
 }
 
-func getAlbumTracks(client *api.Client, id int64, formatter *output.Formatter) {
-	result, err := client.GetAlbumTracks(id, limit)
+func getUser(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	user, err := client.GetUserContext(ctx, id)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting user: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatUser(user)
+}
+
+func getAlbumTracks(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	result, err := client.GetAlbumTracksContext(ctx, id, limit)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting album tracks: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatTracks(result.Data)
 }
 
-func getArtistTopTracks(client *api.Client, id int64, formatter *output.Formatter) {
-	result, err := client.GetArtistTopTracks(id, limit)
+func getPlaylistTracks(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	if idsOnly || outputFormat == "ids" {
+		streamTrackIDs(ctx, client.PlaylistTracksPager(id, limit))
+		return
+	}
+
+	result, err := client.GetPlaylistTracksContext(ctx, id, limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting playlist tracks: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatTracks(result.Data)
+}
+
+// streamTrackIDs prints track IDs as pages arrive instead of waiting for
+// the whole collection, keeping memory bounded to a single page.
+func streamTrackIDs(ctx context.Context, pager *api.Pager[api.Track]) {
+	for {
+		track, err := pager.Next(ctx)
+		if errors.Is(err, api.ErrDone) {
+			return
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting tracks: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		fmt.Println(track.ID)
+	}
+}
+
+func getArtistTopTracks(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	result, err := client.GetArtistTopTracksContext(ctx, id, limit)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting artist top tracks: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatTracks(result.Data)
 }
 
-func getArtistAlbums(client *api.Client, id int64, formatter *output.Formatter) {
-	result, err := client.GetArtistAlbums(id, limit)
+func getArtistAlbums(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	result, err := client.GetArtistAlbumsContext(ctx, id, limit)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting artist albums: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
+	resolveAlbumGenres(ctx, client, result.Data)
 	formatter.FormatAlbums(result.Data)
 }
 
-func getShowEpisodes(client *api.Client, id int64, formatter *output.Formatter) {
-	result, err := client.GetShowEpisodes(id, limit)
+func getShowEpisodes(ctx context.Context, client *api.Client, id int64, formatter *output.Formatter) {
+	result, err := client.GetShowEpisodesContext(ctx, id, limit)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error getting show episodes: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatEpisodes(result.Data)
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/felipemarinho97/deezer-cli/internal/output"
+)
+
+var (
+	isrcCode string
+	upcCode  string
+	isrcFile string
+	upcFile  string
+)
+
+var (
+	isrcPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+	upcPattern  = regexp.MustCompile(`^[0-9]{8,14}$`)
+)
+
+func init() {
+	getCmd.Flags().StringVar(&isrcCode, "isrc", "", "Look up a track by ISRC")
+	getCmd.Flags().StringVar(&upcCode, "upc", "", "Look up an album by UPC")
+	getCmd.Flags().StringVar(&isrcFile, "isrc-file", "", "Look up tracks for every ISRC in a file, one per line (- for stdin)")
+	getCmd.Flags().StringVar(&upcFile, "upc-file", "", "Look up albums for every UPC in a file, one per line (- for stdin)")
+}
+
+// isCodeLookup reports whether get was asked to look items up by ISRC or
+// UPC rather than by ID.
+func isCodeLookup() bool {
+	return isrcCode != "" || upcCode != "" || isrcFile != "" || upcFile != ""
+}
+
+func getByCode(ctx context.Context, client *api.Client, itemType string, formatter *output.Formatter) {
+	switch {
+	case (isrcCode != "" || isrcFile != "") && itemType != "track":
+		fmt.Fprintf(os.Stderr, "--isrc and --isrc-file only apply to tracks: deezer-cli get track --isrc CODE\n")
+		os.Exit(exitUsage)
+	case (upcCode != "" || upcFile != "") && itemType != "album":
+		fmt.Fprintf(os.Stderr, "--upc and --upc-file only apply to albums: deezer-cli get album --upc CODE\n")
+		os.Exit(exitUsage)
+	}
+
+	switch {
+	case isrcCode != "":
+		getTrackByISRC(ctx, client, isrcCode, formatter)
+	case upcCode != "":
+		getAlbumByUPC(ctx, client, upcCode, formatter)
+	case isrcFile != "":
+		status := &batchStatus{}
+		isrcs := readCodes(isrcFile, normalizeISRC, status)
+		formatter.FormatTracks(collectBatch(client.GetTracksByISRC(ctx, isrcs), "track", status))
+		status.exit()
+	case upcFile != "":
+		status := &batchStatus{}
+		upcs := readCodes(upcFile, normalizeUPC, status)
+		formatter.FormatAlbums(collectBatch(client.GetAlbumsByUPC(ctx, upcs), "album", status))
+		status.exit()
+	}
+}
+
+func getTrackByISRC(ctx context.Context, client *api.Client, code string, formatter *output.Formatter) {
+	isrc, err := normalizeISRC(code)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid ISRC: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	track, err := client.GetTrackByISRCContext(ctx, isrc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting track: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	formatter.FormatTrack(track)
+}
+
+func getAlbumByUPC(ctx context.Context, client *api.Client, code string, formatter *output.Formatter) {
+	upc, err := normalizeUPC(code)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid UPC: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	album, err := client.GetAlbumByUPCContext(ctx, upc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting album: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	// Show the album exactly as get album ID would, with its tracklist and
+	// genre name.
+	getAlbum(ctx, client, album.ID, formatter)
+}
+
+// normalizeISRC upper-cases an ISRC and drops the hyphens and spaces it is
+// often printed with, e.g. US-QX9-13-00108.
+func normalizeISRC(code string) (string, error) {
+	isrc := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if !isrcPattern.MatchString(isrc) {
+		return "", fmt.Errorf("%q is not a 12-character ISRC", code)
+	}
+	return isrc, nil
+}
+
+func normalizeUPC(code string) (string, error) {
+	upc := strings.NewReplacer("-", "", " ", "").Replace(code)
+	if !upcPattern.MatchString(upc) {
+		return "", fmt.Errorf("%q is not a UPC or EAN", code)
+	}
+	return upc, nil
+}
+
+// readCodes reads one code per line from path, or stdin for "-", skipping
+// blank lines and lines starting with #. Invalid codes are reported as
+// failures.
+func readCodes(path string, normalize func(string) (string, error), status *batchStatus) []string {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening %s: %v\n", path, err)
+			os.Exit(exitError)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var codes []string
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		status.total++
+		code, err := normalize(line)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping line %d: %v\n", lineNumber, err)
+			status.fail(err)
+			continue
+		}
+		codes = append(codes, code)
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", path, err)
+		os.Exit(exitError)
+	}
+
+	return codes
+}
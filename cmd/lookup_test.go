@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizeISRC(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"USQX91300108", "USQX91300108"},
+		{"usqx91300108", "USQX91300108"},
+		{"US-QX9-13-00108", "USQX91300108"},
+		{" US QX9 13 00108 ", "USQX91300108"},
+	}
+	for _, tt := range tests {
+		got, err := normalizeISRC(tt.code)
+		if err != nil {
+			t.Errorf("normalizeISRC(%q): %v", tt.code, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeISRC(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+
+	for _, code := range []string{"", "USQX9130010", "USQX913001089", "1SQX91300108", "USQX913001AB"} {
+		if got, err := normalizeISRC(code); err == nil {
+			t.Errorf("normalizeISRC(%q) = %q, want an error", code, got)
+		}
+	}
+}
+
+func TestNormalizeUPC(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"724384960650", "724384960650"},
+		{"7 24384 96065 0", "724384960650"},
+		{"0-724384-960650", "0724384960650"},
+		{"12345678", "12345678"},
+	}
+	for _, tt := range tests {
+		got, err := normalizeUPC(tt.code)
+		if err != nil {
+			t.Errorf("normalizeUPC(%q): %v", tt.code, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeUPC(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+
+	for _, code := range []string{"", "1234567", "123456789012345", "72438496065A"} {
+		if got, err := normalizeUPC(code); err == nil {
+			t.Errorf("normalizeUPC(%q) = %q, want an error", code, got)
+		}
+	}
+}
+
+func TestReadCodes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "isrcs.txt")
+	input := "# exported ISRCs\nUS-QX9-13-00108\n\nnot an isrc\ngbaye0601498\n"
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	status := &batchStatus{}
+	codes := readCodes(path, normalizeISRC, status)
+
+	if fmt.Sprint(codes) != "[USQX91300108 GBAYE0601498]" {
+		t.Errorf("codes = %v, want [USQX91300108 GBAYE0601498]", codes)
+	}
+	if status.total != 3 || status.failed != 1 {
+		t.Errorf("status = %d of %d failed, want 1 of 3", status.failed, status.total)
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var playlistsCmd = &cobra.Command{
+	Use:   "playlists artist [id]",
+	Short: "Get playlists featuring an artist",
+	Long: `Get the playlists that feature a specific artist.
+	
+Examples:
+  deezer-cli playlists artist 27
+  deezer-cli playlists artist 27 --limit 50 --output csv`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if args[0] != "artist" || len(args) != 2 {
+			fmt.Fprintf(os.Stderr, "Use: deezer-cli playlists artist [id]\n")
+			os.Exit(exitUsage)
+		}
+
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseIDArg(ctx, client, args)
+
+		result, err := client.GetArtistPlaylistsContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting artist playlists: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatPlaylists(result.Data)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(playlistsCmd)
+}
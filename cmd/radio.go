@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var radioCmd = &cobra.Command{
+	Use:   "radio",
+	Short: "Browse radios and artist mixes",
+	Long: `Browse Deezer radios and get their tracks, or the radio mix generated from an artist.
+	
+Examples:
+  deezer-cli radio list
+  deezer-cli radio get 37151
+  deezer-cli radio tracks 37151 --output csv
+  deezer-cli radio artist 27 --ids-only`,
+}
+
+var radioListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List radios",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		result, err := newClient().GetRadiosContext(cmd.Context(), limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting radios: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatRadios(result.Data)
+	},
+}
+
+var radioGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get details for a radio",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "radio", args[0])
+
+		radio, err := client.GetRadioContext(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting radio: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatRadio(radio)
+	},
+}
+
+var radioTracksCmd = &cobra.Command{
+	Use:   "tracks [id]",
+	Short: "Get the tracks of a radio",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "radio", args[0])
+
+		result, err := client.GetRadioTracksContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting radio tracks: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatTracks(result.Data)
+	},
+}
+
+var radioArtistCmd = &cobra.Command{
+	Use:   "artist [id]",
+	Short: "Get the radio mix of an artist",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseID(ctx, client, "artist", args[0])
+
+		result, err := client.GetArtistRadioContext(ctx, id, limit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting artist radio: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+
+		newFormatter().FormatTracks(result.Data)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(radioCmd)
+	radioCmd.AddCommand(radioListCmd)
+	radioCmd.AddCommand(radioGetCmd)
+	radioCmd.AddCommand(radioTracksCmd)
+	radioCmd.AddCommand(radioArtistCmd)
+}
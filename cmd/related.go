@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/felipemarinho97/deezer-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	relatedDepth    int
+	relatedMaxNodes int
+	relatedGraph    string
+)
+
+var relatedCmd = &cobra.Command{
+	Use:   "related artist [id]",
+	Short: "Get related artists, optionally crawling several hops out",
+	Long: `Get the artists related to an artist. With --depth N the related-artist graph is
+crawled breadth-first N hops out, visiting each artist once and stopping after
+--max-nodes artists. --limit bounds the related artists fetched per artist.
+
+With --graph the crawl, including the root artist, is written as a graph for
+tools like Graphviz or Gephi: dot, graphml, or json (nodes and edges).
+	
+Examples:
+  deezer-cli related artist 27
+  deezer-cli related artist 27 --depth 2 --limit 10
+  deezer-cli related artist 27 --depth 3 --max-nodes 250 --output csv
+  deezer-cli related artist 27 --depth 2 --graph dot | dot -Tsvg > daft-punk.svg
+  deezer-cli related artist 27 --depth 3 --graph graphml > daft-punk.graphml`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if args[0] != "artist" {
+			fmt.Fprintf(os.Stderr, "Use: deezer-cli related artist [id]\n")
+			os.Exit(exitUsage)
+		}
+		checkCrawlFlags()
+
+		ctx := cmd.Context()
+		client := newClient()
+		id := parseIDArg(ctx, client, args)
+
+		graph := crawlRelatedArtists(ctx, client, id)
+
+		formatter := newFormatter()
+		if relatedGraph != "" {
+			writeArtistGraph(graph, formatter)
+		} else {
+			formatter.FormatRelatedArtists(graph.Nodes[1:])
+		}
+
+		if len(graph.Failed) > 0 {
+			os.Exit(exitPartial)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(relatedCmd)
+	addCrawlFlags(relatedCmd)
+}
+
+// addCrawlFlags registers the related-artist crawl and graph export flags,
+// shared by related artist and get artist.
+func addCrawlFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&relatedDepth, "depth", api.DefaultCrawlDepth, "Number of hops to crawl from the artist")
+	cmd.Flags().IntVar(&relatedMaxNodes, "max-nodes", api.DefaultCrawlMaxNodes, "Maximum number of artists to collect (0 for no cap)")
+	cmd.Flags().StringVar(&relatedGraph, "graph", "", "Write the crawl as a graph: dot, graphml, json")
+}
+
+func checkCrawlFlags() {
+	if relatedDepth < 1 {
+		fmt.Fprintf(os.Stderr, "--depth must be at least 1\n")
+		os.Exit(exitUsage)
+	}
+	if relatedGraph != "" && !slices.Contains(output.GraphFormats, relatedGraph) {
+		fmt.Fprintf(os.Stderr, "Unknown graph format: %s. Use %s\n", relatedGraph, strings.Join(output.GraphFormats, ", "))
+		os.Exit(exitUsage)
+	}
+}
+
+// crawlRelatedArtists crawls the related-artist graph of id with the crawl
+// flags, reporting artists that could not be expanded on stderr.
+func crawlRelatedArtists(ctx context.Context, client *api.Client, id int64) *api.ArtistGraph {
+	maxNodes := relatedMaxNodes
+	if maxNodes == 0 {
+		// A zero value in api.CrawlOptions selects the default.
+		maxNodes = -1
+	}
+
+	graph, err := client.CrawlRelatedArtistsContext(ctx, id, api.CrawlOptions{
+		Depth:     relatedDepth,
+		MaxNodes:  maxNodes,
+		PerArtist: limit,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting related artists: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	for _, failure := range graph.Failed {
+		fmt.Fprintf(os.Stderr, "Skipping related artists of %v\n", failure)
+	}
+
+	return graph
+}
+
+func writeArtistGraph(graph *api.ArtistGraph, formatter *output.Formatter) {
+	if err := formatter.FormatArtistGraph(graph, relatedGraph); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing graph: %v\n", err)
+		os.Exit(exitError)
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve [url...]",
+	Short: "Resolve Deezer URLs and share links to a type and ID",
+	Long: `Detect the entity type and ID from any Deezer URL form, following the redirects of
+deezer.page.link and link.deezer.com share links.
+	
+Examples:
+  deezer-cli resolve https://www.deezer.com/en/album/302127
+  deezer-cli resolve deezer.com/us/track/3135556 --output json
+  deezer-cli resolve https://deezer.page.link/abc123 --ids-only`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := newClient()
+		formatter := newFormatter()
+
+		var resources []api.Resource
+		status := &batchStatus{total: len(args)}
+		for _, arg := range args {
+			resource, err := client.ResolveURLContext(ctx, arg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", arg, err)
+				status.fail(err)
+				continue
+			}
+			resources = append(resources, resource)
+		}
+
+		formatter.FormatResources(resources)
+		status.exit()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resolveCmd)
+}
+
+// resolveArg resolves a URL argument, exiting on failure.
+func resolveArg(ctx context.Context, client *api.Client, arg string) api.Resource {
+	resource, err := client.ResolveURLContext(ctx, arg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error resolving URL: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	return resource
+}
+
+// resolveID parses a numeric ID or a Deezer URL, which must point to an
+// item of itemType.
+func resolveID(ctx context.Context, client *api.Client, itemType string, arg string) (int64, error) {
+	if !api.IsURL(arg) {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ID %q", arg)
+		}
+		return id, nil
+	}
+
+	resource, err := client.ResolveURLContext(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	if itemType == "podcast" {
+		itemType = "show"
+	}
+	if resource.Type != itemType {
+		return 0, fmt.Errorf("%s is a %s URL, expected %s", arg, resource.Type, itemType)
+	}
+
+	return resource.ID, nil
+}
@@ -1,17 +1,42 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/felipemarinho97/deezer-cli/internal/cache"
+	"github.com/felipemarinho97/deezer-cli/internal/config"
+	"github.com/felipemarinho97/deezer-cli/internal/output"
+	"github.com/felipemarinho97/deezer-cli/internal/ratelimit"
 	"github.com/spf13/cobra"
 )
 
 var (
 	outputFormat string
 	limit        int
+	allResults   bool
 	idsOnly      bool
 	fields       []string
+	noCache      bool
+	cacheTTL     int
+	profileName  string
+	baseURL      string
+	proxyURL     string
+	country      string
+	timeout      int
+	caBundle     string
+	userAgent    string
+	maxRetries   int
+	rateLimit    int
+	ratePeriod   int
+	sharedLimit  bool
+	concurrency  int
 )
 
 var rootCmd = &cobra.Command{
@@ -22,18 +47,170 @@ var rootCmd = &cobra.Command{
 This tool allows you to search for tracks, albums, artists, and playlists,
 get detailed information by ID, and format output for both human reading
 and piping to other commands.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		loadSettings(cmd)
+
+		if allResults {
+			limit = 0
+		}
+	},
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	// Cancel in-flight requests on Ctrl-C instead of waiting for them.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(exitUsage)
 	}
 }
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, json, csv, yaml, ids")
-	rootCmd.PersistentFlags().IntVarP(&limit, "limit", "l", 25, "Limit number of results")
+	rootCmd.PersistentFlags().IntVarP(&limit, "limit", "l", 25, "Limit number of results (0 for all)")
+	rootCmd.PersistentFlags().BoolVar(&allResults, "all", false, "Fetch all results, following pagination")
 	rootCmd.PersistentFlags().BoolVar(&idsOnly, "ids-only", false, "Display only IDs")
 	rootCmd.PersistentFlags().StringSliceVarP(&fields, "fields", "f", []string{}, "Select specific fields to display")
-}
\ No newline at end of file
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Disable the response cache")
+	rootCmd.PersistentFlags().IntVar(&cacheTTL, "cache-ttl", 300, "Cache TTL in seconds (0 disables caching)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Configuration profile to use (or DEEZER_CLI_PROFILE)")
+	rootCmd.PersistentFlags().StringVar(&baseURL, "base-url", api.BaseURL, "Deezer API base URL")
+	rootCmd.PersistentFlags().IntVar(&timeout, "timeout", 10, "HTTP request timeout in seconds")
+	rootCmd.PersistentFlags().StringVar(&proxyURL, "proxy", "", "HTTP, HTTPS or SOCKS5 proxy URL")
+	rootCmd.PersistentFlags().StringVar(&caBundle, "ca-bundle", "", "PEM file with additional trusted CA certificates")
+	rootCmd.PersistentFlags().StringVar(&userAgent, "user-agent", api.DefaultUserAgent, "User-Agent header sent to the API")
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "retries", api.DefaultRetries, "Retries for quota, server and network errors (0 to disable)")
+	rootCmd.PersistentFlags().IntVar(&rateLimit, "rate-limit", ratelimit.DefaultRequests, "Requests allowed per rate limit period")
+	rootCmd.PersistentFlags().IntVar(&ratePeriod, "rate-period", int(ratelimit.DefaultPeriod/time.Second), "Rate limit period in seconds")
+	rootCmd.PersistentFlags().BoolVar(&sharedLimit, "shared-rate-limit", false, "Share the rate limit budget with other deezer-cli processes")
+	rootCmd.PersistentFlags().IntVar(&concurrency, "concurrency", api.DefaultConcurrency, "Parallel requests for batch commands and related-artist crawls")
+}
+
+// loadSettings fills every flag the user did not set explicitly from the
+// environment, then the selected profile, then the config file, then the
+// built-in defaults.
+func loadSettings(cmd *cobra.Command) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Run 'deezer-cli config validate' for details\n")
+		os.Exit(exitUsage)
+	}
+
+	if profileName == "" {
+		profileName = config.ProfileFromEnv()
+	}
+	if profileName != "" {
+		if err := cfg.ApplyProfile(profileName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitUsage)
+		}
+	}
+
+	if err := config.ApplyEnv(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	flags := cmd.Flags()
+	if !flags.Changed("output") {
+		outputFormat = cfg.DefaultFormat
+	}
+	if !flags.Changed("limit") {
+		limit = cfg.DefaultLimit
+	}
+	if !flags.Changed("no-cache") {
+		noCache = !cfg.CacheEnabled
+	}
+	if !flags.Changed("cache-ttl") {
+		cacheTTL = cfg.CacheTTL
+	}
+
+	if !flags.Changed("base-url") {
+		baseURL = cfg.BaseURL
+	}
+	if !flags.Changed("timeout") {
+		timeout = cfg.Timeout
+	} else if timeout < 1 {
+		fmt.Fprintf(os.Stderr, "Error: --timeout must be at least 1 second, got %d\n", timeout)
+		os.Exit(exitUsage)
+	}
+	if !flags.Changed("proxy") {
+		proxyURL = cfg.Proxy
+	}
+	if !flags.Changed("ca-bundle") {
+		caBundle = cfg.CABundle
+	}
+	if !flags.Changed("user-agent") {
+		userAgent = cfg.UserAgent
+	}
+	if !flags.Changed("retries") {
+		maxRetries = cfg.MaxRetries
+	}
+	if !flags.Changed("rate-limit") {
+		rateLimit = cfg.RateRequests
+	}
+	if !flags.Changed("rate-period") {
+		ratePeriod = cfg.RatePeriod
+	}
+	if !flags.Changed("shared-rate-limit") {
+		sharedLimit = cfg.RateShared
+	}
+	if !flags.Changed("concurrency") {
+		concurrency = cfg.Concurrency
+	}
+
+	country = cfg.Country
+}
+
+func newClient() *api.Client {
+	retries := maxRetries
+	if retries == 0 {
+		// A zero value in api.Options selects the default.
+		retries = -1
+	}
+
+	var rateLimitFile string
+	if sharedLimit {
+		dir, err := cache.DefaultDir()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error locating rate limit state: %v\n", err)
+			os.Exit(exitError)
+		}
+		rateLimitFile = filepath.Join(dir, "ratelimit.state")
+	}
+
+	client, err := api.NewClientWithOptions(api.Options{
+		BaseURL:           baseURL,
+		Timeout:           time.Duration(timeout) * time.Second,
+		Proxy:             proxyURL,
+		CABundle:          caBundle,
+		UserAgent:         userAgent,
+		CacheEnabled:      !noCache,
+		CacheTTL:          time.Duration(cacheTTL) * time.Second,
+		MaxRetries:        retries,
+		RateLimitRequests: rateLimit,
+		RateLimitPeriod:   time.Duration(ratePeriod) * time.Second,
+		RateLimitFile:     rateLimitFile,
+		Concurrency:       concurrency,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	return client
+}
+
+func newFormatter() *output.Formatter {
+	formatter := output.NewFormatter(outputFormat, idsOnly, fields)
+	formatter.SetCountry(country)
+	return formatter
+}
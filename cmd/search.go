@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -33,24 +34,25 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		query := args[0]
-		client := api.NewClient()
-		formatter := output.NewFormatter(outputFormat, idsOnly, fields)
+		ctx := cmd.Context()
+		client := newClient()
+		formatter := newFormatter()
 
 		switch strings.ToLower(searchType) {
 		case "track", "tracks":
-			searchTracks(client, query, formatter)
+			searchTracks(ctx, client, query, formatter)
 		case "album", "albums":
-			searchAlbums(client, query, formatter)
+			searchAlbums(ctx, client, query, formatter)
 		case "artist", "artists":
-			searchArtists(client, query, formatter)
+			searchArtists(ctx, client, query, formatter)
 		case "playlist", "playlists":
-			searchPlaylists(client, query, formatter)
+			searchPlaylists(ctx, client, query, formatter)
 		case "show", "shows", "podcast", "podcasts":
-			searchShows(client, query, formatter)
+			searchShows(ctx, client, query, formatter)
 		case "episode", "episodes":
-			searchEpisodes(client, query, formatter)
+			searchEpisodes(ctx, client, query, formatter)
 		default:
-			searchAll(client, query, formatter)
+			searchAll(ctx, client, query, formatter)
 		}
 	},
 }
@@ -63,11 +65,11 @@ func init() {
 	searchCmd.Flags().BoolVar(&exact, "exact", false, "Use exact matching for filters")
 }
 
-func searchTracks(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchTracks(query, limit, 0)
+func searchTracks(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchTracksContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching tracks: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	tracks := result.Data
@@ -83,11 +85,11 @@ func searchTracks(client *api.Client, query string, formatter *output.Formatter)
 	formatter.FormatTracks(tracks)
 }
 
-func searchAlbums(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchAlbums(query, limit, 0)
+func searchAlbums(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchAlbumsContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching albums: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	albums := result.Data
@@ -96,67 +98,68 @@ func searchAlbums(client *api.Client, query string, formatter *output.Formatter)
 		albums = api.FilterAlbumsByArtist(albums, artistFilter)
 	}
 
+	resolveAlbumGenres(ctx, client, albums)
 	formatter.FormatAlbums(albums)
 }
 
-func searchArtists(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchArtists(query, limit, 0)
+func searchArtists(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchArtistsContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching artists: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatArtists(result.Data)
 }
 
-func searchPlaylists(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchPlaylists(query, limit, 0)
+func searchPlaylists(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchPlaylistsContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching playlists: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatPlaylists(result.Data)
 }
 
-func searchShows(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchShows(query, limit, 0)
+func searchShows(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchShowsContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching shows: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatShows(result.Data)
 }
 
-func searchEpisodes(client *api.Client, query string, formatter *output.Formatter) {
-	result, err := client.SearchEpisodes(query, limit, 0)
+func searchEpisodes(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
+	result, err := client.SearchEpisodesContext(ctx, query, limit, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error searching episodes: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 
 	formatter.FormatEpisodes(result.Data)
 }
 
-func searchAll(client *api.Client, query string, formatter *output.Formatter) {
+func searchAll(ctx context.Context, client *api.Client, query string, formatter *output.Formatter) {
 	fmt.Println("=== TRACKS ===")
-	searchTracks(client, query, formatter)
+	searchTracks(ctx, client, query, formatter)
 
 	fmt.Println("\n=== ALBUMS ===")
-	searchAlbums(client, query, formatter)
+	searchAlbums(ctx, client, query, formatter)
 
 	fmt.Println("\n=== ARTISTS ===")
-	searchArtists(client, query, formatter)
+	searchArtists(ctx, client, query, formatter)
 
 	fmt.Println("\n=== PLAYLISTS ===")
-	searchPlaylists(client, query, formatter)
+	searchPlaylists(ctx, client, query, formatter)
 
 	fmt.Println("\n=== SHOWS ===")
-	searchShows(client, query, formatter)
+	searchShows(ctx, client, query, formatter)
 
 	fmt.Println("\n=== EPISODES ===")
-	searchEpisodes(client, query, formatter)
+	searchEpisodes(ctx, client, query, formatter)
 }
 
 func filterTracksByAlbum(tracks []api.Track, albumName string) []api.Track {
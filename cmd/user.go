@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+var userChartType string
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Browse a user's public playlists, favorites and follows",
+	Long: `Browse public data of a Deezer user, such as the creator of a playlist.
+Use "get user [id]" for the profile itself.
+	
+Examples:
+  deezer-cli user playlists 5
+  deezer-cli user tracks 5 --limit 50 --output csv
+  deezer-cli user followings 5
+  deezer-cli user charts 5 --type artists`,
+}
+
+// userListCmd builds a "user [name] [id]" subcommand that fetches a list
+// for the user and prints it.
+func userListCmd(name string, short string, run func(ctx context.Context, client *api.Client, id int64)) *cobra.Command {
+	return &cobra.Command{
+		Use:   name + " [id]",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			client := newClient()
+			id := parseID(ctx, client, "user", args[0])
+			run(ctx, client, id)
+		},
+	}
+}
+
+var userPlaylistsCmd = userListCmd("playlists", "Get the public playlists of a user", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserPlaylistsContext(ctx, id, limit)
+	exitOnUserError("playlists", err)
+	newFormatter().FormatPlaylists(result.Data)
+})
+
+var userAlbumsCmd = userListCmd("albums", "Get the favorite albums of a user", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserAlbumsContext(ctx, id, limit)
+	exitOnUserError("albums", err)
+	resolveAlbumGenres(ctx, client, result.Data)
+	newFormatter().FormatAlbums(result.Data)
+})
+
+var userArtistsCmd = userListCmd("artists", "Get the favorite artists of a user", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserArtistsContext(ctx, id, limit)
+	exitOnUserError("artists", err)
+	newFormatter().FormatArtists(result.Data)
+})
+
+var userTracksCmd = userListCmd("tracks", "Get the favorite tracks of a user", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserTracksContext(ctx, id, limit)
+	exitOnUserError("tracks", err)
+	newFormatter().FormatTracks(result.Data)
+})
+
+var userFollowingsCmd = userListCmd("followings", "Get the users a user follows", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserFollowingsContext(ctx, id, limit)
+	exitOnUserError("followings", err)
+	newFormatter().FormatUsers(result.Data)
+})
+
+var userFollowersCmd = userListCmd("followers", "Get the followers of a user", func(ctx context.Context, client *api.Client, id int64) {
+	result, err := client.GetUserFollowersContext(ctx, id, limit)
+	exitOnUserError("followers", err)
+	newFormatter().FormatUsers(result.Data)
+})
+
+var userChartsCmd = userListCmd("charts", "Get what a user listens to most", func(ctx context.Context, client *api.Client, id int64) {
+	formatter := newFormatter()
+
+	switch strings.ToLower(userChartType) {
+	case "track", "tracks":
+		result, err := client.GetUserChartTracksContext(ctx, id, limit)
+		exitOnUserError("chart tracks", err)
+		formatter.FormatTracks(result.Data)
+	case "album", "albums":
+		result, err := client.GetUserChartAlbumsContext(ctx, id, limit)
+		exitOnUserError("chart albums", err)
+		resolveAlbumGenres(ctx, client, result.Data)
+		formatter.FormatAlbums(result.Data)
+	case "artist", "artists":
+		result, err := client.GetUserChartArtistsContext(ctx, id, limit)
+		exitOnUserError("chart artists", err)
+		formatter.FormatArtists(result.Data)
+	case "playlist", "playlists":
+		result, err := client.GetUserChartPlaylistsContext(ctx, id, limit)
+		exitOnUserError("chart playlists", err)
+		formatter.FormatPlaylists(result.Data)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown chart: %s. Use %s\n", userChartType, strings.Join(api.UserChartTypes, ", "))
+		os.Exit(exitUsage)
+	}
+})
+
+func exitOnUserError(what string, err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting user %s: %v\n", what, err)
+		os.Exit(exitCode(err))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(userCmd)
+	userCmd.AddCommand(userPlaylistsCmd)
+	userCmd.AddCommand(userAlbumsCmd)
+	userCmd.AddCommand(userArtistsCmd)
+	userCmd.AddCommand(userTracksCmd)
+	userCmd.AddCommand(userFollowingsCmd)
+	userCmd.AddCommand(userFollowersCmd)
+	userCmd.AddCommand(userChartsCmd)
+
+	userChartsCmd.Flags().StringVarP(&userChartType, "type", "t", "tracks", "Chart to show: tracks, albums, artists, playlists")
+}
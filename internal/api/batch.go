@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+const DefaultConcurrency = 4
+
+// BatchResult holds the outcome of fetching one ID in a batch. Exactly one
+// of Item and Err is set. Batches looked up by code, such as ISRCs, set Key
+// to the code and ID to the ID of the item found.
+type BatchResult[T any] struct {
+	ID   int64
+	Key  string
+	Item *T
+	Err  error
+}
+
+// Batch calls fetch for every ID through a bounded pool of workers,
+// returning results in the order of ids. Requests still go through the
+// client's rate limiter and cache.
+func Batch[T any](ctx context.Context, c *Client, ids []int64, fetch func(ctx context.Context, id int64) (*T, error)) []BatchResult[T] {
+	results := make([]BatchResult[T], len(ids))
+	jobs := make(chan int)
+
+	workers := c.concurrency
+	if workers > len(ids) {
+		workers = len(ids)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				item, err := fetch(ctx, ids[i])
+				results[i] = BatchResult[T]{ID: ids[i], Item: item, Err: err}
+			}
+		}()
+	}
+
+	for i := range ids {
+		if ctx.Err() != nil {
+			results[i] = BatchResult[T]{ID: ids[i], Err: ctx.Err()}
+			continue
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func (c *Client) GetTracks(ctx context.Context, ids []int64) []BatchResult[Track] {
+	return Batch(ctx, c, ids, c.GetTrackContext)
+}
+
+func (c *Client) GetAlbums(ctx context.Context, ids []int64) []BatchResult[Album] {
+	return Batch(ctx, c, ids, c.GetAlbumContext)
+}
+
+func (c *Client) GetArtists(ctx context.Context, ids []int64) []BatchResult[Artist] {
+	return Batch(ctx, c, ids, c.GetArtistContext)
+}
+
+func (c *Client) GetPlaylists(ctx context.Context, ids []int64) []BatchResult[Playlist] {
+	return Batch(ctx, c, ids, c.GetPlaylistContext)
+}
+
+func (c *Client) GetShows(ctx context.Context, ids []int64) []BatchResult[Show] {
+	return Batch(ctx, c, ids, c.GetShowContext)
+}
+
+func (c *Client) GetEpisodes(ctx context.Context, ids []int64) []BatchResult[Episode] {
+	return Batch(ctx, c, ids, c.GetEpisodeContext)
+}
+
+func (c *Client) GetUsers(ctx context.Context, ids []int64) []BatchResult[User] {
+	return Batch(ctx, c, ids, c.GetUserContext)
+}
+
+// GetTracksByISRC looks up many ISRCs concurrently, returning results in the
+// order of isrcs.
+func (c *Client) GetTracksByISRC(ctx context.Context, isrcs []string) []BatchResult[Track] {
+	return batchByKey(ctx, c, isrcs, c.GetTrackByISRCContext, func(t *Track) int64 { return t.ID })
+}
+
+// GetAlbumsByUPC looks up many UPCs concurrently, returning results in the
+// order of upcs.
+func (c *Client) GetAlbumsByUPC(ctx context.Context, upcs []string) []BatchResult[Album] {
+	return batchByKey(ctx, c, upcs, c.GetAlbumByUPCContext, func(a *Album) int64 { return a.ID })
+}
+
+// batchByKey runs Batch over the positions of keys so lookups by code share
+// the same worker pool.
+func batchByKey[T any](ctx context.Context, c *Client, keys []string, fetch func(ctx context.Context, key string) (*T, error), idOf func(*T) int64) []BatchResult[T] {
+	positions := make([]int64, len(keys))
+	for i := range positions {
+		positions[i] = int64(i)
+	}
+
+	results := Batch(ctx, c, positions, func(ctx context.Context, i int64) (*T, error) {
+		return fetch(ctx, keys[i])
+	})
+
+	for i := range results {
+		results[i].Key = keys[i]
+		results[i].ID = 0
+		if results[i].Item != nil {
+			results[i].ID = idOf(results[i].Item)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBatchKeepsOrder(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	ids := []int64{5, 4, 3, 2, 1, 10, 9, 8}
+	results := Batch(context.Background(), client, ids, func(ctx context.Context, id int64) (*item, error) {
+		// Later IDs finish first, so results arrive out of order.
+		time.Sleep(time.Duration(id) * time.Millisecond)
+		if id%3 == 0 {
+			return nil, fmt.Errorf("item %d failed", id)
+		}
+		return &item{ID: id}, nil
+	})
+
+	if len(results) != len(ids) {
+		t.Fatalf("got %d results, want %d", len(results), len(ids))
+	}
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("result %d ID = %d, want %d", i, result.ID, ids[i])
+		}
+		if failed := ids[i]%3 == 0; failed != (result.Err != nil) || failed == (result.Item != nil) {
+			t.Errorf("result %d = %+v, want exactly one of Item and Err", i, result)
+		}
+		if result.Item != nil && result.Item.ID != ids[i] {
+			t.Errorf("result %d item = %d, want %d", i, result.Item.ID, ids[i])
+		}
+	}
+}
+
+func TestBatchBoundsConcurrency(t *testing.T) {
+	client, err := NewClientWithOptions(Options{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	ids := make([]int64, 10)
+	Batch(context.Background(), client, ids, func(ctx context.Context, id int64) (*item, error) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+
+		time.Sleep(2 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return &item{ID: id}, nil
+	})
+
+	if peak != 2 {
+		t.Errorf("%d requests ran at once, want 2", peak)
+	}
+}
+
+func TestBatchStopsWhenCanceled(t *testing.T) {
+	client := newTestClient(t, http.NotFoundHandler())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	fetched := 0
+	ids := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	results := Batch(ctx, client, ids, func(ctx context.Context, id int64) (*item, error) {
+		mu.Lock()
+		fetched++
+		mu.Unlock()
+		if id == 2 {
+			cancel()
+		}
+		return &item{ID: id}, nil
+	})
+
+	if len(results) != len(ids) {
+		t.Fatalf("got %d results, want %d", len(results), len(ids))
+	}
+	if fetched == len(ids) {
+		t.Errorf("all %d items were fetched after cancellation", fetched)
+	}
+
+	canceled := 0
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("result %d ID = %d, want %d", i, result.ID, ids[i])
+		}
+		if errors.Is(result.Err, context.Canceled) {
+			canceled++
+		} else if result.Item == nil {
+			t.Errorf("result %d = %+v, want an item or context.Canceled", i, result)
+		}
+	}
+	if canceled != len(ids)-fetched {
+		t.Errorf("%d results canceled, want %d", canceled, len(ids)-fetched)
+	}
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ChartAllGenres selects the overall charts rather than those of a genre.
+const ChartAllGenres int64 = 0
+
+func chartEndpoint(genreID int64, kind string) string {
+	return fmt.Sprintf("/chart/%d/%s", genreID, kind)
+}
+
+func (c *Client) GetChartTracks(genreID int64, limit int) (*TracksResult, error) {
+	return c.GetChartTracksContext(context.Background(), genreID, limit)
+}
+
+func (c *Client) GetChartTracksContext(ctx context.Context, genreID int64, limit int) (*TracksResult, error) {
+	result, err := fetchPages[Track](ctx, c, chartEndpoint(genreID, "tracks"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetChartAlbums(genreID int64, limit int) (*AlbumsResult, error) {
+	return c.GetChartAlbumsContext(context.Background(), genreID, limit)
+}
+
+func (c *Client) GetChartAlbumsContext(ctx context.Context, genreID int64, limit int) (*AlbumsResult, error) {
+	result, err := fetchPages[Album](ctx, c, chartEndpoint(genreID, "albums"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlbumsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetChartArtists(genreID int64, limit int) (*ArtistsResult, error) {
+	return c.GetChartArtistsContext(context.Background(), genreID, limit)
+}
+
+func (c *Client) GetChartArtistsContext(ctx context.Context, genreID int64, limit int) (*ArtistsResult, error) {
+	result, err := fetchPages[Artist](ctx, c, chartEndpoint(genreID, "artists"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetChartPlaylists(genreID int64, limit int) (*PlaylistsResult, error) {
+	return c.GetChartPlaylistsContext(context.Background(), genreID, limit)
+}
+
+func (c *Client) GetChartPlaylistsContext(ctx context.Context, genreID int64, limit int) (*PlaylistsResult, error) {
+	result, err := fetchPages[Playlist](ctx, c, chartEndpoint(genreID, "playlists"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlaylistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetChartPodcasts(genreID int64, limit int) (*ShowsResult, error) {
+	return c.GetChartPodcastsContext(context.Background(), genreID, limit)
+}
+
+func (c *Client) GetChartPodcastsContext(ctx context.Context, genreID int64, limit int) (*ShowsResult, error) {
+	result, err := fetchPages[Show](ctx, c, chartEndpoint(genreID, "podcasts"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShowsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
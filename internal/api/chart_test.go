@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// endpointServer serves a page of three items on every path, recording the
+// paths it receives.
+type endpointServer struct {
+	pages pagedServer
+	mu    sync.Mutex
+	paths []string
+}
+
+func newEndpointServer() *endpointServer {
+	return &endpointServer{pages: pagedServer{total: 3}}
+}
+
+func (s *endpointServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.paths = append(s.paths, r.URL.Path)
+	s.mu.Unlock()
+
+	s.pages.ServeHTTP(w, r)
+}
+
+// lastPath returns the path of the most recent request.
+func (s *endpointServer) lastPath() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.paths) == 0 {
+		return ""
+	}
+	return s.paths[len(s.paths)-1]
+}
+
+// endpointTest checks that a list method requests path and returns the
+// three items served.
+type endpointTest struct {
+	path  string
+	fetch func(ctx context.Context, c *Client) (int, error)
+}
+
+func runEndpointTests(t *testing.T, tests []endpointTest) {
+	t.Helper()
+
+	server := newEndpointServer()
+	client := newTestClient(t, server)
+
+	for _, tt := range tests {
+		n, err := tt.fetch(context.Background(), client)
+		if err != nil {
+			t.Errorf("%s: %v", tt.path, err)
+			continue
+		}
+		if got := server.lastPath(); got != tt.path {
+			t.Errorf("requested %s, want %s", got, tt.path)
+		}
+		if n != 3 {
+			t.Errorf("%s: got %d items, want 3", tt.path, n)
+		}
+	}
+}
+
+func TestChartEndpoints(t *testing.T) {
+	runEndpointTests(t, []endpointTest{
+		{"/chart/0/tracks", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetChartTracksContext(ctx, ChartAllGenres, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/chart/132/albums", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetChartAlbumsContext(ctx, 132, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/chart/0/artists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetChartArtistsContext(ctx, ChartAllGenres, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/chart/116/playlists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetChartPlaylistsContext(ctx, 116, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/chart/0/podcasts", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetChartPodcastsContext(ctx, ChartAllGenres, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+	})
+}
@@ -1,220 +1,389 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/felipemarinho97/deezer-cli/internal/cache"
+	"github.com/felipemarinho97/deezer-cli/internal/ratelimit"
 )
 
 const (
-	BaseURL = "https://api.deezer.com"
+	BaseURL          = "https://api.deezer.com"
+	DefaultTimeout   = 10 * time.Second
+	DefaultUserAgent = "deezer-cli"
+	DefaultRetries   = 3
 )
 
 type Client struct {
-	httpClient  *http.Client
-	baseURL     string
-	rateLimiter *time.Ticker
-	cache       *cache.Cache
+	httpClient     *http.Client
+	baseURL        string
+	userAgent      string
+	rateLimiter    ratelimit.Limiter
+	cache          *cache.Cache
+	maxRetries     int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	concurrency    int
 }
 
 func NewClient() *Client {
 	return &Client{
-		httpClient:  &http.Client{Timeout: 10 * time.Second},
-		baseURL:     BaseURL,
-		rateLimiter: time.NewTicker(50 * time.Millisecond), // 20 requests per second max
-		cache:       cache.New(5*time.Minute, 10*time.Minute),
+		httpClient:     &http.Client{Timeout: DefaultTimeout},
+		baseURL:        BaseURL,
+		userAgent:      DefaultUserAgent,
+		rateLimiter:    ratelimit.New(ratelimit.DefaultRequests, ratelimit.DefaultPeriod),
+		cache:          newCache(5 * time.Minute),
+		maxRetries:     DefaultRetries,
+		retryBaseDelay: defaultRetryBaseDelay,
+		retryMaxDelay:  defaultRetryMaxDelay,
+		concurrency:    DefaultConcurrency,
 	}
 }
 
 func NewClientWithCache(cacheEnabled bool, ttl time.Duration) *Client {
+	client, _ := NewClientWithOptions(Options{CacheEnabled: cacheEnabled, CacheTTL: ttl})
+	return client
+}
+
+// Options configures a Client. Zero values select the defaults.
+type Options struct {
+	BaseURL      string
+	Timeout      time.Duration
+	Proxy        string
+	CABundle     string
+	UserAgent    string
+	CacheEnabled bool
+	CacheTTL     time.Duration
+	// MaxRetries is the number of retries after a failed request; use a
+	// negative value to disable retries.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// At most RateLimitRequests requests are made in any span of
+	// RateLimitPeriod. When RateLimitFile is set, the budget is shared
+	// through that file with every other process using it.
+	RateLimitRequests int
+	RateLimitPeriod   time.Duration
+	RateLimitFile     string
+	// Concurrency bounds the number of parallel requests made by batch
+	// methods such as GetTracks.
+	Concurrency int
+}
+
+func NewClientWithOptions(opts Options) (*Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.CABundle != "" {
+		pem, err := os.ReadFile(opts.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", opts.CABundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	baseURL := BaseURL
+	if opts.BaseURL != "" {
+		baseURL = strings.TrimRight(opts.BaseURL, "/")
+	}
+
+	timeout := DefaultTimeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+
+	userAgent := DefaultUserAgent
+	if opts.UserAgent != "" {
+		userAgent = opts.UserAgent
+	}
+
+	maxRetries := DefaultRetries
+	if opts.MaxRetries > 0 {
+		maxRetries = opts.MaxRetries
+	} else if opts.MaxRetries < 0 {
+		maxRetries = 0
+	}
+
+	retryBaseDelay := defaultRetryBaseDelay
+	if opts.RetryBaseDelay > 0 {
+		retryBaseDelay = opts.RetryBaseDelay
+	}
+
+	retryMaxDelay := defaultRetryMaxDelay
+	if opts.RetryMaxDelay > 0 {
+		retryMaxDelay = opts.RetryMaxDelay
+	}
+
+	requests := ratelimit.DefaultRequests
+	if opts.RateLimitRequests > 0 {
+		requests = opts.RateLimitRequests
+	}
+
+	period := ratelimit.DefaultPeriod
+	if opts.RateLimitPeriod > 0 {
+		period = opts.RateLimitPeriod
+	}
+
+	var limiter ratelimit.Limiter = ratelimit.New(requests, period)
+	if opts.RateLimitFile != "" {
+		shared, err := ratelimit.NewShared(opts.RateLimitFile, requests, period)
+		if err != nil {
+			return nil, err
+		}
+		limiter = shared
+	}
+
+	concurrency := DefaultConcurrency
+	if opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
 	client := &Client{
-		httpClient:  &http.Client{Timeout: 10 * time.Second},
-		baseURL:     BaseURL,
-		rateLimiter: time.NewTicker(50 * time.Millisecond),
+		httpClient:     &http.Client{Timeout: timeout, Transport: transport},
+		baseURL:        baseURL,
+		userAgent:      userAgent,
+		rateLimiter:    limiter,
+		maxRetries:     maxRetries,
+		retryBaseDelay: retryBaseDelay,
+		retryMaxDelay:  retryMaxDelay,
+		concurrency:    concurrency,
 	}
 
-	if cacheEnabled {
-		client.cache = cache.New(ttl, ttl*2)
+	if opts.CacheEnabled {
+		client.cache = newCache(opts.CacheTTL)
 	}
 
-	return client
+	return client, nil
 }
 
-func (c *Client) get(endpoint string, params url.Values) ([]byte, error) {
+// newCache prefers the shared on-disk cache and falls back to an in-memory
+// one when the cache directory is unavailable.
+func newCache(ttl time.Duration) *cache.Cache {
+	if dir, err := cache.DefaultDir(); err == nil {
+		if diskCache, err := cache.NewDisk(dir, ttl); err == nil {
+			return diskCache
+		}
+	}
+
+	return cache.New(ttl, ttl*2)
+}
+
+func (c *Client) getContext(ctx context.Context, endpoint string, params url.Values) ([]byte, error) {
 	cacheKey := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if c.baseURL != BaseURL {
+		// Keep responses from alternative servers apart from the real API.
+		cacheKey = c.baseURL + cacheKey
+	}
 
 	if c.cache != nil {
-		var cachedData []byte
+		var cachedData json.RawMessage
 		if c.cache.Get(cacheKey, &cachedData) {
 			return cachedData, nil
 		}
 	}
 
-	<-c.rateLimiter.C
-
 	fullURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
 	if params != nil && len(params) > 0 {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, params.Encode())
 	}
 
-	resp, err := c.httpClient.Get(fullURL)
+	for attempt := 0; ; attempt++ {
+		body, err := c.fetch(ctx, endpoint, fullURL)
+		if err == nil {
+			if c.cache != nil {
+				c.cache.Set(cacheKey, json.RawMessage(body))
+			}
+			return body, nil
+		}
+
+		var retryErr *retryableError
+		if !errors.As(err, &retryErr) {
+			return nil, err
+		}
+		if attempt >= c.maxRetries {
+			return nil, retryErr.err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.backoff(attempt, retryErr.retryAfter)):
+		}
+	}
+}
+
+// fetch performs a single rate-limited request. Failures worth retrying
+// are wrapped in a retryableError.
+func (c *Client) fetch(ctx context.Context, endpoint string, fullURL string) ([]byte, error) {
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, &retryableError{err: fmt.Errorf("request failed: %w", err)}
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+		err := &Error{StatusCode: resp.StatusCode, Endpoint: endpoint}
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return nil, &retryableError{err: err, retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+		}
+		return nil, err
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, &retryableError{err: fmt.Errorf("failed to read response: %w", err)}
 	}
 
 	var errorCheck struct {
-		Error *struct {
-			Type    string `json:"type"`
-			Message string `json:"message"`
-			Code    int    `json:"code"`
-		} `json:"error"`
+		Error *Error `json:"error"`
 	}
 
 	if err := json.Unmarshal(body, &errorCheck); err == nil && errorCheck.Error != nil {
-		return nil, fmt.Errorf("API error: %s", errorCheck.Error.Message)
-	}
-
-	if c.cache != nil {
-		c.cache.Set(cacheKey, body)
+		err := errorCheck.Error
+		err.StatusCode = resp.StatusCode
+		err.Endpoint = endpoint
+
+		switch err.Code {
+		case CodeQuotaExceeded:
+			retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+			if retryAfter < quotaWindow {
+				retryAfter = quotaWindow
+			}
+			return nil, &retryableError{err: err, retryAfter: retryAfter}
+		case CodeServiceBusy:
+			return nil, &retryableError{err: err}
+		}
+		return nil, err
 	}
 
 	return body, nil
 }
 
 func (c *Client) SearchTracks(query string, limit int, index int) (*TrackSearchResult, error) {
+	return c.SearchTracksContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchTracksContext(ctx context.Context, query string, limit int, index int) (*TrackSearchResult, error) {
 	params := url.Values{}
 	params.Set("q", query)
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
-	if index > 0 {
-		params.Set("index", fmt.Sprintf("%d", index))
-	}
 
-	data, err := c.get("/search/track", params)
+	result, err := fetchPages[Track](ctx, c, "/search/track", params, limit, index)
 	if err != nil {
 		return nil, err
 	}
 
-	var result TrackSearchResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &TrackSearchResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) SearchAlbums(query string, limit int, index int) (*AlbumSearchResult, error) {
+	return c.SearchAlbumsContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchAlbumsContext(ctx context.Context, query string, limit int, index int) (*AlbumSearchResult, error) {
 	params := url.Values{}
 	params.Set("q", query)
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
-	if index > 0 {
-		params.Set("index", fmt.Sprintf("%d", index))
-	}
 
-	data, err := c.get("/search/album", params)
+	result, err := fetchPages[Album](ctx, c, "/search/album", params, limit, index)
 	if err != nil {
 		return nil, err
 	}
 
-	var result AlbumSearchResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &AlbumSearchResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) SearchArtists(query string, limit int, index int) (*ArtistSearchResult, error) {
+	return c.SearchArtistsContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchArtistsContext(ctx context.Context, query string, limit int, index int) (*ArtistSearchResult, error) {
 	params := url.Values{}
 	params.Set("q", query)
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
-	if index > 0 {
-		params.Set("index", fmt.Sprintf("%d", index))
-	}
 
-	data, err := c.get("/search/artist", params)
+	result, err := fetchPages[Artist](ctx, c, "/search/artist", params, limit, index)
 	if err != nil {
 		return nil, err
 	}
 
-	var result ArtistSearchResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &ArtistSearchResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) SearchPlaylists(query string, limit int, index int) (*PlaylistSearchResult, error) {
+	return c.SearchPlaylistsContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchPlaylistsContext(ctx context.Context, query string, limit int, index int) (*PlaylistSearchResult, error) {
 	params := url.Values{}
 	params.Set("q", query)
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
-	if index > 0 {
-		params.Set("index", fmt.Sprintf("%d", index))
-	}
 
-	data, err := c.get("/search/playlist", params)
+	result, err := fetchPages[Playlist](ctx, c, "/search/playlist", params, limit, index)
 	if err != nil {
 		return nil, err
 	}
 
-	var result PlaylistSearchResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &PlaylistSearchResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) SearchShows(query string, limit int, index int) (*ShowSearchResult, error) {
+	return c.SearchShowsContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchShowsContext(ctx context.Context, query string, limit int, index int) (*ShowSearchResult, error) {
 	params := url.Values{}
 	params.Set("q", query)
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
-	if index > 0 {
-		params.Set("index", fmt.Sprintf("%d", index))
-	}
 
-	data, err := c.get("/search/podcast", params)
+	result, err := fetchPages[Show](ctx, c, "/search/podcast", params, limit, index)
 	if err != nil {
 		return nil, err
 	}
 
-	var result ShowSearchResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &ShowSearchResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) SearchEpisodes(query string, limit int, index int) (*EpisodeSearchResult, error) {
+	return c.SearchEpisodesContext(context.Background(), query, limit, index)
+}
+
+func (c *Client) SearchEpisodesContext(ctx context.Context, query string, limit int, index int) (*EpisodeSearchResult, error) {
 	// Search for shows matching the query
-	shows, err := c.SearchShows(query, 10, index)
+	shows, err := c.SearchShowsContext(ctx, query, 10, index)
 	if err != nil {
 		return nil, err
 	}
@@ -228,8 +397,16 @@ func (c *Client) SearchEpisodes(query string, limit int, index int) (*EpisodeSea
 			break
 		}
 
-		episodes, err := c.GetShowEpisodes(show.ID, limit)
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - episodeCount
+		}
+
+		episodes, err := c.GetShowEpisodesContext(ctx, show.ID, remaining)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			continue // Skip shows that fail to load episodes
 		}
 
@@ -251,8 +428,12 @@ func (c *Client) SearchEpisodes(query string, limit int, index int) (*EpisodeSea
 }
 
 func (c *Client) GetTrack(id int64) (*Track, error) {
+	return c.GetTrackContext(context.Background(), id)
+}
+
+func (c *Client) GetTrackContext(ctx context.Context, id int64) (*Track, error) {
 	endpoint := fmt.Sprintf("/track/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +447,89 @@ func (c *Client) GetTrack(id int64) (*Track, error) {
 }
 
 func (c *Client) GetAlbum(id int64) (*Album, error) {
+	return c.GetAlbumContext(context.Background(), id)
+}
+
+func (c *Client) GetAlbumContext(ctx context.Context, id int64) (*Album, error) {
 	endpoint := fmt.Sprintf("/album/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var album Album
+	if err := json.Unmarshal(data, &album); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &album, nil
+}
+
+func (c *Client) GetAlbumWithTracks(id int64) (*Album, error) {
+	return c.GetAlbumWithTracksContext(context.Background(), id)
+}
+
+// GetAlbumWithTracksContext returns the album with its full tracklist. The
+// tracks embedded in /album/{id} are capped and carry no disc or position,
+// so unless they are complete and numbered they are replaced with every
+// page of /album/{id}/tracks.
+func (c *Client) GetAlbumWithTracksContext(ctx context.Context, id int64) (*Album, error) {
+	album, err := c.GetAlbumContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if album.Tracks != nil && len(album.Tracks.Data) >= album.NbTracks && hasPositions(album.Tracks.Data) {
+		return album, nil
+	}
+
+	tracks, err := c.GetAlbumTracksContext(ctx, id, 0)
+	if err != nil {
+		return nil, err
+	}
+	album.Tracks = &TracksData{Data: tracks.Data}
+
+	return album, nil
+}
+
+func hasPositions(tracks []Track) bool {
+	for _, track := range tracks {
+		if track.TrackPosition == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) GetTrackByISRC(isrc string) (*Track, error) {
+	return c.GetTrackByISRCContext(context.Background(), isrc)
+}
+
+// GetTrackByISRCContext looks up a track by its International Standard
+// Recording Code.
+func (c *Client) GetTrackByISRCContext(ctx context.Context, isrc string) (*Track, error) {
+	endpoint := "/track/isrc:" + url.PathEscape(isrc)
+	data, err := c.getContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var track Track
+	if err := json.Unmarshal(data, &track); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &track, nil
+}
+
+func (c *Client) GetAlbumByUPC(upc string) (*Album, error) {
+	return c.GetAlbumByUPCContext(context.Background(), upc)
+}
+
+// GetAlbumByUPCContext looks up an album by its Universal Product Code.
+func (c *Client) GetAlbumByUPCContext(ctx context.Context, upc string) (*Album, error) {
+	endpoint := "/album/upc:" + url.PathEscape(upc)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -281,8 +543,12 @@ func (c *Client) GetAlbum(id int64) (*Album, error) {
 }
 
 func (c *Client) GetArtist(id int64) (*Artist, error) {
+	return c.GetArtistContext(context.Background(), id)
+}
+
+func (c *Client) GetArtistContext(ctx context.Context, id int64) (*Artist, error) {
 	endpoint := fmt.Sprintf("/artist/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +562,12 @@ func (c *Client) GetArtist(id int64) (*Artist, error) {
 }
 
 func (c *Client) GetPlaylist(id int64) (*Playlist, error) {
+	return c.GetPlaylistContext(context.Background(), id)
+}
+
+func (c *Client) GetPlaylistContext(ctx context.Context, id int64) (*Playlist, error) {
 	endpoint := fmt.Sprintf("/playlist/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +581,12 @@ func (c *Client) GetPlaylist(id int64) (*Playlist, error) {
 }
 
 func (c *Client) GetShow(id int64) (*Show, error) {
+	return c.GetShowContext(context.Background(), id)
+}
+
+func (c *Client) GetShowContext(ctx context.Context, id int64) (*Show, error) {
 	endpoint := fmt.Sprintf("/podcast/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,8 +600,12 @@ func (c *Client) GetShow(id int64) (*Show, error) {
 }
 
 func (c *Client) GetEpisode(id int64) (*Episode, error) {
+	return c.GetEpisodeContext(context.Background(), id)
+}
+
+func (c *Client) GetEpisodeContext(ctx context.Context, id int64) (*Episode, error) {
 	endpoint := fmt.Sprintf("/episode/%d", id)
-	data, err := c.get(endpoint, nil)
+	data, err := c.getContext(ctx, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -341,83 +619,108 @@ func (c *Client) GetEpisode(id int64) (*Episode, error) {
 }
 
 func (c *Client) GetAlbumTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetAlbumTracksContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetAlbumTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
 	endpoint := fmt.Sprintf("/album/%d/tracks", id)
-	params := url.Values{}
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
 
-	data, err := c.get(endpoint, params)
+	result, err := fetchPages[Track](ctx, c, endpoint, url.Values{}, limit, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	var result TracksResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetPlaylistTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetPlaylistTracksContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetPlaylistTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
+	endpoint := fmt.Sprintf("/playlist/%d/tracks", id)
+
+	result, err := fetchPages[Track](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	return &result, nil
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) GetArtistAlbums(id int64, limit int) (*AlbumsResult, error) {
+	return c.GetArtistAlbumsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetArtistAlbumsContext(ctx context.Context, id int64, limit int) (*AlbumsResult, error) {
 	endpoint := fmt.Sprintf("/artist/%d/albums", id)
-	params := url.Values{}
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
 
-	data, err := c.get(endpoint, params)
+	result, err := fetchPages[Album](ctx, c, endpoint, url.Values{}, limit, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	var result AlbumsResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &AlbumsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) GetArtistTopTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetArtistTopTracksContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetArtistTopTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
 	endpoint := fmt.Sprintf("/artist/%d/top", id)
-	params := url.Values{}
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
+
+	result, err := fetchPages[Track](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	data, err := c.get(endpoint, params)
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetArtistPlaylists(id int64, limit int) (*PlaylistsResult, error) {
+	return c.GetArtistPlaylistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetArtistPlaylistsContext(ctx context.Context, id int64, limit int) (*PlaylistsResult, error) {
+	endpoint := fmt.Sprintf("/artist/%d/playlists", id)
+
+	result, err := fetchPages[Playlist](ctx, c, endpoint, url.Values{}, limit, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	var result TracksResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return &PlaylistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetArtistFans(id int64, limit int) (*UsersResult, error) {
+	return c.GetArtistFansContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetArtistFansContext(ctx context.Context, id int64, limit int) (*UsersResult, error) {
+	endpoint := fmt.Sprintf("/artist/%d/fans", id)
+
+	result, err := fetchPages[User](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	return &result, nil
+	return &UsersResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func (c *Client) GetShowEpisodes(id int64, limit int) (*EpisodesResult, error) {
+	return c.GetShowEpisodesContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetShowEpisodesContext(ctx context.Context, id int64, limit int) (*EpisodesResult, error) {
 	endpoint := fmt.Sprintf("/podcast/%d/episodes", id)
-	params := url.Values{}
-	if limit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", limit))
-	}
 
-	data, err := c.get(endpoint, params)
+	result, err := fetchPages[Episode](ctx, c, endpoint, url.Values{}, limit, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	var result EpisodesResult
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	return &EpisodesResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
 }
 
 func FilterByArtist(tracks []Track, artistName string) []Track {
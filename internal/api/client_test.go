@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// albumServer serves /album/{id} with the given album and its paged
+// /album/{id}/tracks from tracks, recording the paths it receives.
+type albumServer struct {
+	album    Album
+	tracks   []Track
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *albumServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.Path)
+	s.mu.Unlock()
+
+	switch r.URL.Path {
+	case fmt.Sprintf("/album/%d", s.album.ID):
+		json.NewEncoder(w).Encode(s.album)
+	case fmt.Sprintf("/album/%d/tracks", s.album.ID):
+		json.NewEncoder(w).Encode(page[Track]{Data: s.tracks, Total: len(s.tracks)})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func numberedTracks(n int) []Track {
+	tracks := make([]Track, n)
+	for i := range tracks {
+		tracks[i] = Track{ID: int64(100 + i), DiskNumber: 1, TrackPosition: i + 1}
+	}
+	return tracks
+}
+
+func TestGetAlbumWithTracksFetchesTracklist(t *testing.T) {
+	tracks := numberedTracks(3)
+
+	// The embedded tracks are unnumbered, as /album/{id} returns them.
+	embedded := []Track{{ID: 100}, {ID: 101}, {ID: 102}}
+	server := &albumServer{
+		album:  Album{ID: 1, NbTracks: 3, Tracks: &TracksData{Data: embedded}},
+		tracks: tracks,
+	}
+	client := newTestClient(t, server)
+
+	album, err := client.GetAlbumWithTracksContext(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(album.Tracks.Data) != 3 {
+		t.Fatalf("got %d tracks, want 3", len(album.Tracks.Data))
+	}
+	for i, track := range album.Tracks.Data {
+		if track.TrackPosition != i+1 || track.DiskNumber != 1 {
+			t.Errorf("track %d at disc %d position %d, want disc 1 position %d",
+				track.ID, track.DiskNumber, track.TrackPosition, i+1)
+		}
+	}
+	if fmt.Sprint(server.requests) != "[/album/1 /album/1/tracks]" {
+		t.Errorf("requests = %v", server.requests)
+	}
+}
+
+func TestGetAlbumWithTracksCompletesCappedTracklist(t *testing.T) {
+	tracks := numberedTracks(5)
+	server := &albumServer{
+		album:  Album{ID: 1, NbTracks: 5, Tracks: &TracksData{Data: tracks[:2]}},
+		tracks: tracks,
+	}
+	client := newTestClient(t, server)
+
+	album, err := client.GetAlbumWithTracksContext(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(album.Tracks.Data) != 5 {
+		t.Errorf("got %d tracks, want 5", len(album.Tracks.Data))
+	}
+}
+
+func TestGetAlbumWithTracksKeepsCompleteTracklist(t *testing.T) {
+	server := &albumServer{
+		album: Album{ID: 1, NbTracks: 2, Tracks: &TracksData{Data: numberedTracks(2)}},
+	}
+	client := newTestClient(t, server)
+
+	album, err := client.GetAlbumWithTracksContext(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(album.Tracks.Data) != 2 {
+		t.Errorf("got %d tracks, want 2", len(album.Tracks.Data))
+	}
+	if len(server.requests) != 1 {
+		t.Errorf("requests = %v, want only /album/1", server.requests)
+	}
+}
+
+// codeServer answers /track/isrc:{code} and /album/upc:{code} for the codes
+// in known with an item carrying the code and its ID, and with Deezer's
+// "no data" error for the rest.
+func codeServer(known map[string]int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code := r.URL.Path[strings.LastIndex(r.URL.Path, ":")+1:]
+		id, ok := known[code]
+		switch {
+		case !ok:
+			json.NewEncoder(w).Encode(map[string]any{
+				"error": map[string]any{"type": "DataException", "message": "no data", "code": CodeDataNotFound},
+			})
+		case strings.HasPrefix(r.URL.Path, "/track/isrc:"):
+			json.NewEncoder(w).Encode(Track{ID: id, ISRC: code})
+		case strings.HasPrefix(r.URL.Path, "/album/upc:"):
+			json.NewEncoder(w).Encode(Album{ID: id, UPC: code})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestGetTrackByISRC(t *testing.T) {
+	client := newTestClient(t, codeServer(map[string]int64{"USQX91300108": 67238735}))
+
+	track, err := client.GetTrackByISRCContext(context.Background(), "USQX91300108")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if track.ID != 67238735 || track.ISRC != "USQX91300108" {
+		t.Errorf("track = %d %s, want 67238735 USQX91300108", track.ID, track.ISRC)
+	}
+
+	_, err = client.GetTrackByISRCContext(context.Background(), "GBAYE0000000")
+	if !IsNotFound(err) {
+		t.Errorf("unknown ISRC error = %v, want not found", err)
+	}
+}
+
+func TestGetAlbumByUPC(t *testing.T) {
+	client := newTestClient(t, codeServer(map[string]int64{"724384960650": 302127}))
+
+	album, err := client.GetAlbumByUPCContext(context.Background(), "724384960650")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if album.ID != 302127 || album.UPC != "724384960650" {
+		t.Errorf("album = %d %s, want 302127 724384960650", album.ID, album.UPC)
+	}
+
+	_, err = client.GetAlbumByUPCContext(context.Background(), "000000000000")
+	if !IsNotFound(err) {
+		t.Errorf("unknown UPC error = %v, want not found", err)
+	}
+}
+
+func TestGetAlbumsByUPCKeepsOrder(t *testing.T) {
+	client := newTestClient(t, codeServer(map[string]int64{"111111111111": 1, "333333333333": 3}))
+
+	upcs := []string{"333333333333", "222222222222", "111111111111"}
+	results := client.GetAlbumsByUPC(context.Background(), upcs)
+
+	if len(results) != len(upcs) {
+		t.Fatalf("got %d results, want %d", len(results), len(upcs))
+	}
+	wantIDs := []int64{3, 0, 1}
+	for i, result := range results {
+		if result.Key != upcs[i] {
+			t.Errorf("result %d key = %q, want %q", i, result.Key, upcs[i])
+		}
+		if result.ID != wantIDs[i] {
+			t.Errorf("result %d ID = %d, want %d", i, result.ID, wantIDs[i])
+		}
+	}
+	if !IsNotFound(results[1].Err) || results[1].Item != nil {
+		t.Errorf("missing UPC result = %+v, want a not found error", results[1])
+	}
+}
+
+func TestArtistPlaylistsAndFans(t *testing.T) {
+	runEndpointTests(t, []endpointTest{
+		{"/artist/27/playlists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetArtistPlaylistsContext(ctx, 27, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/artist/27/fans", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetArtistFansContext(ctx, 27, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+	})
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// Deezer error codes, see https://developers.deezer.com/api/errors.
+const (
+	CodeQuotaExceeded      = 4
+	CodeItemsLimitExceeded = 100
+	CodePermission         = 200
+	CodeTokenInvalid       = 300
+	CodeParameter          = 500
+	CodeParameterMissing   = 501
+	CodeQueryInvalid       = 600
+	CodeServiceBusy        = 700
+	CodeDataNotFound       = 800
+	CodeIndividualAccount  = 901
+)
+
+// Error is returned for failed API calls, either for a non-200 HTTP status
+// or for the error object Deezer embeds in otherwise successful responses.
+type Error struct {
+	Type       string `json:"type,omitempty"`
+	Code       int    `json:"code,omitempty"`
+	Message    string `json:"message"`
+	StatusCode int    `json:"status_code"`
+	Endpoint   string `json:"endpoint"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == 0 && e.Type == "" {
+		return fmt.Sprintf("API returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("API error: %s", e.Message)
+}
+
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == CodeDataNotFound || apiErr.StatusCode == http.StatusNotFound
+}
+
+func IsQuota(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == CodeQuotaExceeded || apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+func IsInvalidParam(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case CodeParameter, CodeParameterMissing, CodeQueryInvalid:
+		return true
+	}
+	return false
+}
+
+// IsNetwork reports whether err was caused by a transport failure such as
+// a DNS error, refused connection or timeout rather than an API response.
+func IsNetwork(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func IsAPIError(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr)
+}
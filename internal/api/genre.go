@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) GetGenres(limit int) (*GenresResult, error) {
+	return c.GetGenresContext(context.Background(), limit)
+}
+
+func (c *Client) GetGenresContext(ctx context.Context, limit int) (*GenresResult, error) {
+	result, err := fetchPages[Genre](ctx, c, "/genre", url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GenresResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetGenre(id int64) (*Genre, error) {
+	return c.GetGenreContext(context.Background(), id)
+}
+
+func (c *Client) GetGenreContext(ctx context.Context, id int64) (*Genre, error) {
+	endpoint := fmt.Sprintf("/genre/%d", id)
+	data, err := c.getContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var genre Genre
+	if err := json.Unmarshal(data, &genre); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &genre, nil
+}
+
+func (c *Client) GetGenreArtists(id int64, limit int) (*ArtistsResult, error) {
+	return c.GetGenreArtistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetGenreArtistsContext(ctx context.Context, id int64, limit int) (*ArtistsResult, error) {
+	endpoint := fmt.Sprintf("/genre/%d/artists", id)
+
+	result, err := fetchPages[Artist](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetGenreRadios(id int64, limit int) (*RadiosResult, error) {
+	return c.GetGenreRadiosContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetGenreRadiosContext(ctx context.Context, id int64, limit int) (*RadiosResult, error) {
+	endpoint := fmt.Sprintf("/genre/%d/radios", id)
+
+	result, err := fetchPages[Radio](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RadiosResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+// ResolveAlbumGenreContext fills in the genres of an album that only
+// carries a genre ID, as albums embedded in other objects do.
+func (c *Client) ResolveAlbumGenreContext(ctx context.Context, album *Album) error {
+	if album.GenreID <= 0 || (album.Genres != nil && len(album.Genres.Data) > 0) {
+		return nil
+	}
+
+	genre, err := c.GetGenreContext(ctx, int64(album.GenreID))
+	if err != nil {
+		return err
+	}
+
+	album.Genres = &GenresData{Data: []Genre{*genre}}
+	return nil
+}
+
+// ResolveAlbumGenresContext fills in the genres of every album that only
+// carries a genre ID, as albums in search results and album lists do. All
+// genres are fetched with a single /genre request, and only when an album
+// needs one.
+func (c *Client) ResolveAlbumGenresContext(ctx context.Context, albums []Album) error {
+	needed := false
+	for _, album := range albums {
+		if album.GenreID > 0 && (album.Genres == nil || len(album.Genres.Data) == 0) {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil
+	}
+
+	result, err := c.GetGenresContext(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	genres := make(map[int64]Genre, len(result.Data))
+	for _, genre := range result.Data {
+		genres[genre.ID] = genre
+	}
+
+	for i := range albums {
+		album := &albums[i]
+		if album.GenreID <= 0 || (album.Genres != nil && len(album.Genres.Data) > 0) {
+			continue
+		}
+		if genre, ok := genres[int64(album.GenreID)]; ok {
+			album.Genres = &GenresData{Data: []Genre{genre}}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// genreServer serves /genre and /genre/{id} for a fixed set of genres and
+// records the paths it receives.
+type genreServer struct {
+	genres   []Genre
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *genreServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.Path)
+	s.mu.Unlock()
+
+	if r.URL.Path == "/genre" {
+		json.NewEncoder(w).Encode(page[Genre]{Data: s.genres, Total: len(s.genres)})
+		return
+	}
+
+	for _, genre := range s.genres {
+		if r.URL.Path == "/genre/"+strconv.FormatInt(genre.ID, 10) {
+			json.NewEncoder(w).Encode(genre)
+			return
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"type": "DataException", "message": "no data", "code": 800},
+	})
+}
+
+func testGenres() []Genre {
+	return []Genre{{ID: 0, Name: "All"}, {ID: 113, Name: "Dance"}, {ID: 132, Name: "Pop"}}
+}
+
+func TestResolveAlbumGenre(t *testing.T) {
+	server := &genreServer{genres: testGenres()}
+	client := newTestClient(t, server)
+
+	album := &Album{ID: 1, GenreID: 113}
+	if err := client.ResolveAlbumGenreContext(context.Background(), album); err != nil {
+		t.Fatal(err)
+	}
+	if names := album.GetGenreNames(); len(names) != 1 || names[0] != "Dance" {
+		t.Errorf("genres = %v, want [Dance]", names)
+	}
+
+	// Albums that embed their genres, or have none, need no request.
+	embedded := &Album{ID: 2, GenreID: 113, Genres: &GenresData{Data: []Genre{{ID: 113, Name: "Electro"}}}}
+	if err := client.ResolveAlbumGenreContext(context.Background(), embedded); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ResolveAlbumGenreContext(context.Background(), &Album{ID: 3, GenreID: -1}); err != nil {
+		t.Fatal(err)
+	}
+	if names := embedded.GetGenreNames(); names[0] != "Electro" {
+		t.Errorf("embedded genres = %v, want [Electro]", names)
+	}
+	if len(server.requests) != 1 {
+		t.Errorf("requests = %v, want only /genre/113", server.requests)
+	}
+}
+
+func TestResolveAlbumGenreReturnsErrors(t *testing.T) {
+	client := newTestClient(t, &genreServer{genres: testGenres()})
+
+	album := &Album{ID: 1, GenreID: 999}
+	if err := client.ResolveAlbumGenreContext(context.Background(), album); err == nil {
+		t.Fatal("unknown genre resolved without error")
+	}
+	if album.Genres != nil {
+		t.Errorf("genres = %v after a failed lookup, want nil", album.Genres)
+	}
+}
+
+func TestResolveAlbumGenres(t *testing.T) {
+	server := &genreServer{genres: testGenres()}
+	client := newTestClient(t, server)
+
+	albums := []Album{
+		{ID: 1, GenreID: 132},
+		{ID: 2, GenreID: 113},
+		{ID: 3, GenreID: 132},
+		{ID: 4, GenreID: 999},
+		{ID: 5},
+	}
+	if err := client.ResolveAlbumGenresContext(context.Background(), albums); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Pop", "Dance", "Pop", "", ""}
+	for i, album := range albums {
+		var got string
+		if names := album.GetGenreNames(); len(names) > 0 {
+			got = names[0]
+		}
+		if got != want[i] {
+			t.Errorf("album %d genre = %q, want %q", album.ID, got, want[i])
+		}
+	}
+	if len(server.requests) != 1 || server.requests[0] != "/genre" {
+		t.Errorf("requests = %v, want a single /genre", server.requests)
+	}
+}
+
+func TestResolveAlbumGenresSkipsLookupWhenNotNeeded(t *testing.T) {
+	server := &genreServer{genres: testGenres()}
+	client := newTestClient(t, server)
+
+	albums := []Album{
+		{ID: 1},
+		{ID: 2, GenreID: 132, Genres: &GenresData{Data: []Genre{{ID: 132, Name: "Pop"}}}},
+	}
+	if err := client.ResolveAlbumGenresContext(context.Background(), albums); err != nil {
+		t.Fatal(err)
+	}
+	if len(server.requests) != 0 {
+		t.Errorf("requests = %v, want none", server.requests)
+	}
+}
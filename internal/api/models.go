@@ -2,43 +2,112 @@ package api
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
 type Track struct {
-	ID             int64   `json:"id"`
-	Title          string  `json:"title"`
-	TitleShort     string  `json:"title_short"`
-	TitleVersion   string  `json:"title_version"`
-	Link           string  `json:"link"`
-	Duration       int     `json:"duration"`
-	Rank           int     `json:"rank"`
-	ExplicitLyrics bool    `json:"explicit_lyrics"`
-	Preview        string  `json:"preview"`
-	BPM            float64 `json:"bpm"`
-	Gain           float64 `json:"gain"`
-	Artist         Artist  `json:"artist"`
-	Album          Album   `json:"album"`
-	Type           string  `json:"type"`
+	ID                 int64         `json:"id"`
+	Readable           bool          `json:"readable"`
+	Title              string        `json:"title"`
+	TitleShort         string        `json:"title_short"`
+	TitleVersion       string        `json:"title_version"`
+	ISRC               string        `json:"isrc"`
+	Link               string        `json:"link"`
+	Duration           int           `json:"duration"`
+	TrackPosition      int           `json:"track_position"`
+	DiskNumber         int           `json:"disk_number"`
+	Rank               int           `json:"rank"`
+	ReleaseDate        string        `json:"release_date"`
+	ExplicitLyrics     bool          `json:"explicit_lyrics"`
+	Preview            string        `json:"preview"`
+	BPM                float64       `json:"bpm"`
+	Gain               float64       `json:"gain"`
+	AvailableCountries []string      `json:"available_countries"`
+	Contributors       []Contributor `json:"contributors"`
+	MD5Image           string        `json:"md5_image"`
+	Artist             Artist        `json:"artist"`
+	Album              Album         `json:"album"`
+	Type               string        `json:"type"`
 }
 
 type Album struct {
-	ID             int64  `json:"id"`
-	Title          string `json:"title"`
-	Link           string `json:"link"`
-	Cover          string `json:"cover"`
-	CoverSmall     string `json:"cover_small"`
-	CoverMedium    string `json:"cover_medium"`
-	CoverBig       string `json:"cover_big"`
-	CoverXL        string `json:"cover_xl"`
-	GenreID        int    `json:"genre_id"`
-	NbTracks       int    `json:"nb_tracks"`
-	ReleaseDate    string `json:"release_date"`
-	RecordType     string `json:"record_type"`
-	Tracklist      string `json:"tracklist"`
-	ExplicitLyrics bool   `json:"explicit_lyrics"`
-	Artist         Artist `json:"artist"`
-	Type           string `json:"type"`
+	ID             int64         `json:"id"`
+	Title          string        `json:"title"`
+	UPC            string        `json:"upc"`
+	Link           string        `json:"link"`
+	Cover          string        `json:"cover"`
+	CoverSmall     string        `json:"cover_small"`
+	CoverMedium    string        `json:"cover_medium"`
+	CoverBig       string        `json:"cover_big"`
+	CoverXL        string        `json:"cover_xl"`
+	MD5Image       string        `json:"md5_image"`
+	GenreID        int           `json:"genre_id"`
+	Genres         *GenresData   `json:"genres"`
+	Label          string        `json:"label"`
+	NbTracks       int           `json:"nb_tracks"`
+	Duration       int           `json:"duration"`
+	Fans           int           `json:"fans"`
+	ReleaseDate    string        `json:"release_date"`
+	RecordType     string        `json:"record_type"`
+	Available      bool          `json:"available"`
+	Tracklist      string        `json:"tracklist"`
+	ExplicitLyrics bool          `json:"explicit_lyrics"`
+	Contributors   []Contributor `json:"contributors"`
+	Artist         Artist        `json:"artist"`
+	Tracks         *TracksData   `json:"tracks"`
+	Type           string        `json:"type"`
+}
+
+type Genre struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	PictureSmall  string `json:"picture_small"`
+	PictureMedium string `json:"picture_medium"`
+	PictureBig    string `json:"picture_big"`
+	PictureXL     string `json:"picture_xl"`
+	Type          string `json:"type"`
+}
+
+type GenresData struct {
+	Data []Genre `json:"data"`
+}
+
+type GenresResult struct {
+	Data  []Genre `json:"data"`
+	Total int     `json:"total"`
+	Next  string  `json:"next"`
+}
+
+// Radio is a Deezer radio channel, as returned by /radio, /radio/{id} and
+// /genre/{id}/radios.
+type Radio struct {
+	ID            int64  `json:"id"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Share         string `json:"share"`
+	Picture       string `json:"picture"`
+	PictureSmall  string `json:"picture_small"`
+	PictureMedium string `json:"picture_medium"`
+	PictureBig    string `json:"picture_big"`
+	PictureXL     string `json:"picture_xl"`
+	Tracklist     string `json:"tracklist"`
+	MD5Image      string `json:"md5_image"`
+	Type          string `json:"type"`
+}
+
+type RadiosResult struct {
+	Data  []Radio `json:"data"`
+	Total int     `json:"total"`
+	Next  string  `json:"next"`
+}
+
+// Contributor is an artist credited on a track or album, with the role
+// they had on it, such as "Main" or "Featured".
+type Contributor struct {
+	Artist
+	Role string `json:"role"`
 }
 
 type Artist struct {
@@ -81,10 +150,23 @@ type Playlist struct {
 }
 
 type User struct {
-	ID        int64  `json:"id"`
-	Name      string `json:"name"`
-	Tracklist string `json:"tracklist"`
-	Type      string `json:"type"`
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Link          string `json:"link"`
+	Picture       string `json:"picture"`
+	PictureSmall  string `json:"picture_small"`
+	PictureMedium string `json:"picture_medium"`
+	PictureBig    string `json:"picture_big"`
+	PictureXL     string `json:"picture_xl"`
+	Country       string `json:"country"`
+	Tracklist     string `json:"tracklist"`
+	Type          string `json:"type"`
+}
+
+type UsersResult struct {
+	Data  []User `json:"data"`
+	Total int    `json:"total"`
+	Next  string `json:"next"`
 }
 
 type TracksData struct {
@@ -127,6 +209,24 @@ type AlbumsResult struct {
 	Next  string  `json:"next"`
 }
 
+type ArtistsResult struct {
+	Data  []Artist `json:"data"`
+	Total int      `json:"total"`
+	Next  string   `json:"next"`
+}
+
+type PlaylistsResult struct {
+	Data  []Playlist `json:"data"`
+	Total int        `json:"total"`
+	Next  string     `json:"next"`
+}
+
+type ShowsResult struct {
+	Data  []Show `json:"data"`
+	Total int    `json:"total"`
+	Next  string `json:"next"`
+}
+
 type Show struct {
 	ID            int64  `json:"id"`
 	Title         string `json:"title"`
@@ -200,6 +300,22 @@ func (t Track) GetDurationFormatted() string {
 	return fmt.Sprintf("%d:%02d", minutes, seconds)
 }
 
+// GetContributorNames returns the contributors as "Name (role)" strings.
+func (t Track) GetContributorNames() []string {
+	return contributorNames(t.Contributors)
+}
+
+// IsAvailableIn reports whether the track can be streamed in the given
+// ISO 3166-1 country code.
+func (t Track) IsAvailableIn(country string) bool {
+	for _, c := range t.AvailableCountries {
+		if strings.EqualFold(c, country) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a Album) GetID() int64 {
 	return a.ID
 }
@@ -212,6 +328,71 @@ func (a Album) GetArtistName() string {
 	return a.Artist.Name
 }
 
+func (a Album) GetDurationFormatted() string {
+	return formatDuration(a.Duration)
+}
+
+// GetGenreNames returns the names of the genres embedded in the album.
+func (a Album) GetGenreNames() []string {
+	if a.Genres == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(a.Genres.Data))
+	for _, genre := range a.Genres.Data {
+		names = append(names, genre.Name)
+	}
+	return names
+}
+
+func (a Album) GetContributorNames() []string {
+	return contributorNames(a.Contributors)
+}
+
+func contributorNames(contributors []Contributor) []string {
+	names := make([]string, 0, len(contributors))
+	for _, c := range contributors {
+		if c.Role != "" {
+			names = append(names, fmt.Sprintf("%s (%s)", c.Name, c.Role))
+		} else {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// formatDuration formats seconds as m:ss, or h:mm:ss from an hour up.
+func formatDuration(seconds int) string {
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+func (u User) GetID() int64 {
+	return u.ID
+}
+
+func (u User) GetName() string {
+	return u.Name
+}
+
+func (g Genre) GetID() int64 {
+	return g.ID
+}
+
+func (g Genre) GetName() string {
+	return g.Name
+}
+
+func (r Radio) GetID() int64 {
+	return r.ID
+}
+
+func (r Radio) GetTitle() string {
+	return r.Title
+}
+
 func (a Artist) GetID() int64 {
 	return a.ID
 }
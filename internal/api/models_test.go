@@ -0,0 +1,83 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestDecodeTrack(t *testing.T) {
+	data := `{
+		"id": 3135556, "readable": true, "title": "Harder, Better, Faster, Stronger",
+		"isrc": "GBDUW0000059", "duration": 224, "track_position": 4, "disk_number": 1,
+		"bpm": 123.4, "gain": -12.4, "explicit_lyrics": false,
+		"available_countries": ["FR", "US"],
+		"contributors": [{"id": 27, "name": "Daft Punk", "role": "Main"}],
+		"artist": {"id": 27, "name": "Daft Punk"},
+		"album": {"id": 302127, "title": "Discovery"}
+	}`
+
+	var track Track
+	if err := json.Unmarshal([]byte(data), &track); err != nil {
+		t.Fatal(err)
+	}
+
+	if track.TrackPosition != 4 || track.DiskNumber != 1 || track.BPM != 123.4 || track.Gain != -12.4 {
+		t.Errorf("track = %+v", track)
+	}
+	if fmt.Sprint(track.GetContributorNames()) != "[Daft Punk (Main)]" {
+		t.Errorf("contributors = %v", track.GetContributorNames())
+	}
+	if !track.IsAvailableIn("fr") || track.IsAvailableIn("BR") {
+		t.Errorf("availability in FR/BR = %v/%v, want true/false", track.IsAvailableIn("fr"), track.IsAvailableIn("BR"))
+	}
+	if track.Album.Title != "Discovery" {
+		t.Errorf("album = %q, want Discovery", track.Album.Title)
+	}
+}
+
+func TestDecodeAlbum(t *testing.T) {
+	data := `{
+		"id": 302127, "title": "Discovery", "upc": "724384960650", "label": "Parlophone",
+		"genre_id": 113, "genres": {"data": [{"id": 113, "name": "Dance"}]},
+		"nb_tracks": 14, "duration": 3660, "fans": 242000, "available": true,
+		"contributors": [{"id": 27, "name": "Daft Punk", "role": "Main"}, {"id": 28, "name": "Romanthony"}]
+	}`
+
+	var album Album
+	if err := json.Unmarshal([]byte(data), &album); err != nil {
+		t.Fatal(err)
+	}
+
+	if album.UPC != "724384960650" || album.Label != "Parlophone" || album.Fans != 242000 || !album.Available {
+		t.Errorf("album = %+v", album)
+	}
+	if fmt.Sprint(album.GetGenreNames()) != "[Dance]" {
+		t.Errorf("genres = %v, want [Dance]", album.GetGenreNames())
+	}
+	if fmt.Sprint(album.GetContributorNames()) != "[Daft Punk (Main) Romanthony]" {
+		t.Errorf("contributors = %v", album.GetContributorNames())
+	}
+	if got := album.GetDurationFormatted(); got != "1:01:00" {
+		t.Errorf("duration = %s, want 1:01:00", got)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "0:00"},
+		{59, "0:59"},
+		{224, "3:44"},
+		{3599, "59:59"},
+		{3600, "1:00:00"},
+		{4505, "1:15:05"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.seconds); got != tt.want {
+			t.Errorf("formatDuration(%d) = %s, want %s", tt.seconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const maxPageSize = 100
+
+// ErrDone is returned by Pager.Next once every item has been consumed.
+var ErrDone = errors.New("no more items")
+
+type page[T any] struct {
+	Data  []T    `json:"data"`
+	Total int    `json:"total"`
+	Next  string `json:"next"`
+}
+
+// Pager lazily walks a paginated Deezer collection, fetching one page at a
+// time as items are consumed through Next.
+type Pager[T any] struct {
+	client   *Client
+	endpoint string
+	params   url.Values
+	limit    int
+	index    int
+
+	buffer   []T
+	returned int
+	total    int
+	next     string
+	done     bool
+}
+
+func newPager[T any](c *Client, endpoint string, params url.Values, limit int, index int) *Pager[T] {
+	if params == nil {
+		params = url.Values{}
+	}
+
+	return &Pager[T]{
+		client:   c,
+		endpoint: endpoint,
+		params:   params,
+		limit:    limit,
+		index:    index,
+	}
+}
+
+// Next returns the next item of the collection, fetching a new page when
+// the current one is exhausted. It returns ErrDone when there are no more
+// items or the pager limit has been reached.
+func (p *Pager[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+
+	if p.limit > 0 && p.returned >= p.limit {
+		return zero, ErrDone
+	}
+
+	for len(p.buffer) == 0 {
+		if p.done {
+			return zero, ErrDone
+		}
+		if err := p.fetch(ctx); err != nil {
+			return zero, err
+		}
+	}
+
+	item := p.buffer[0]
+	p.buffer = p.buffer[1:]
+	p.returned++
+
+	return item, nil
+}
+
+// Total returns the collection size reported by the API, or 0 before the
+// first page has been fetched.
+func (p *Pager[T]) Total() int {
+	return p.total
+}
+
+func (p *Pager[T]) fetch(ctx context.Context) error {
+	pageSize := maxPageSize
+	if p.limit > 0 && p.limit-p.returned < pageSize {
+		pageSize = p.limit - p.returned
+	}
+
+	params := url.Values{}
+	for key, values := range p.params {
+		params[key] = values
+	}
+	params.Set("limit", strconv.Itoa(pageSize))
+	if p.index > 0 {
+		params.Set("index", strconv.Itoa(p.index))
+	}
+
+	data, err := p.client.getContext(ctx, p.endpoint, params)
+	if err != nil {
+		return err
+	}
+
+	var current page[T]
+	if err := json.Unmarshal(data, &current); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	p.buffer = current.Data
+	p.total = current.Total
+	p.next = current.Next
+
+	if current.Next == "" || len(current.Data) == 0 {
+		p.done = true
+	} else {
+		p.index = nextIndex(current.Next, p.index+len(current.Data))
+	}
+
+	return nil
+}
+
+// collect drains the pager into a single page, preserving the total and
+// next link reported by the last page fetched.
+func (p *Pager[T]) collect(ctx context.Context) (*page[T], error) {
+	result := &page[T]{}
+
+	for {
+		item, err := p.Next(ctx)
+		if errors.Is(err, ErrDone) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Data = append(result.Data, item)
+	}
+
+	result.Total = p.total
+	result.Next = p.next
+
+	return result, nil
+}
+
+// fetchPages follows the Deezer index/next pagination of a list endpoint
+// until limit items have been collected. A limit of 0 fetches every item.
+func fetchPages[T any](ctx context.Context, c *Client, endpoint string, params url.Values, limit int, index int) (*page[T], error) {
+	return newPager[T](c, endpoint, params, limit, index).collect(ctx)
+}
+
+func (c *Client) SearchTracksPager(query string, limit int) *Pager[Track] {
+	return newPager[Track](c, "/search/track", url.Values{"q": {query}}, limit, 0)
+}
+
+func (c *Client) SearchAlbumsPager(query string, limit int) *Pager[Album] {
+	return newPager[Album](c, "/search/album", url.Values{"q": {query}}, limit, 0)
+}
+
+func (c *Client) SearchArtistsPager(query string, limit int) *Pager[Artist] {
+	return newPager[Artist](c, "/search/artist", url.Values{"q": {query}}, limit, 0)
+}
+
+func (c *Client) SearchPlaylistsPager(query string, limit int) *Pager[Playlist] {
+	return newPager[Playlist](c, "/search/playlist", url.Values{"q": {query}}, limit, 0)
+}
+
+func (c *Client) SearchShowsPager(query string, limit int) *Pager[Show] {
+	return newPager[Show](c, "/search/podcast", url.Values{"q": {query}}, limit, 0)
+}
+
+func (c *Client) AlbumTracksPager(id int64, limit int) *Pager[Track] {
+	return newPager[Track](c, fmt.Sprintf("/album/%d/tracks", id), nil, limit, 0)
+}
+
+func (c *Client) PlaylistTracksPager(id int64, limit int) *Pager[Track] {
+	return newPager[Track](c, fmt.Sprintf("/playlist/%d/tracks", id), nil, limit, 0)
+}
+
+func (c *Client) ArtistAlbumsPager(id int64, limit int) *Pager[Album] {
+	return newPager[Album](c, fmt.Sprintf("/artist/%d/albums", id), nil, limit, 0)
+}
+
+func (c *Client) ArtistTopTracksPager(id int64, limit int) *Pager[Track] {
+	return newPager[Track](c, fmt.Sprintf("/artist/%d/top", id), nil, limit, 0)
+}
+
+func (c *Client) ShowEpisodesPager(id int64, limit int) *Pager[Episode] {
+	return newPager[Episode](c, fmt.Sprintf("/podcast/%d/episodes", id), nil, limit, 0)
+}
+
+// nextIndex extracts the index parameter from a "next" URL, falling back
+// to the given value when the URL does not carry one.
+func nextIndex(next string, fallback int) int {
+	u, err := url.Parse(next)
+	if err != nil {
+		return fallback
+	}
+
+	index, err := strconv.Atoi(u.Query().Get("index"))
+	if err != nil || index <= 0 {
+		return fallback
+	}
+
+	return index
+}
@@ -0,0 +1,253 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for a local test server, without caching
+// or retry delays.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClientWithOptions(Options{
+		BaseURL:        server.URL,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+type item struct {
+	ID int64 `json:"id"`
+}
+
+// pagedServer serves a collection of total items as Deezer does, honoring
+// index and limit and linking to the next page, and records the requests
+// it receives.
+type pagedServer struct {
+	total    int
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *pagedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.RawQuery)
+	s.mu.Unlock()
+
+	index, _ := strconv.Atoi(r.URL.Query().Get("index"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 25
+	}
+
+	var response page[item]
+	response.Total = s.total
+	for i := index; i < s.total && i < index+limit; i++ {
+		response.Data = append(response.Data, item{ID: int64(i + 1)})
+	}
+	if index+limit < s.total {
+		response.Next = fmt.Sprintf("http://%s%s?limit=%d&index=%d", r.Host, r.URL.Path, limit, index+limit)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+func (s *pagedServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+func TestFetchPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		limit    int
+		index    int
+		want     int
+		requests int
+	}{
+		{"single page", 10, 25, 0, 10, 1},
+		{"limit within first page", 250, 30, 0, 30, 1},
+		{"limit across pages", 250, 150, 0, 150, 2},
+		{"fetch all", 250, 0, 0, 250, 3},
+		{"limit above total", 120, 500, 0, 120, 2},
+		{"starting index", 250, 0, 200, 50, 1},
+		{"empty collection", 0, 0, 0, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &pagedServer{total: tt.total}
+			client := newTestClient(t, server)
+
+			result, err := fetchPages[item](context.Background(), client, "/items", nil, tt.limit, tt.index)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(result.Data) != tt.want {
+				t.Errorf("got %d items, want %d", len(result.Data), tt.want)
+			}
+			for i, it := range result.Data {
+				if want := int64(tt.index + i + 1); it.ID != want {
+					t.Fatalf("item %d has ID %d, want %d", i, it.ID, want)
+				}
+			}
+			if result.Total != tt.total {
+				t.Errorf("Total = %d, want %d", result.Total, tt.total)
+			}
+			if got := server.requestCount(); got != tt.requests {
+				t.Errorf("made %d requests, want %d", got, tt.requests)
+			}
+		})
+	}
+}
+
+func TestFetchPagesRequestsOnlyWhatIsNeeded(t *testing.T) {
+	server := &pagedServer{total: 250}
+	client := newTestClient(t, server)
+
+	if _, err := fetchPages[item](context.Background(), client, "/items", nil, 130, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"limit=100", "index=100&limit=30"}
+	if len(server.requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", server.requests, want)
+	}
+	for i := range want {
+		if server.requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, server.requests[i], want[i])
+		}
+	}
+}
+
+func TestNextIndex(t *testing.T) {
+	tests := []struct {
+		next     string
+		fallback int
+		want     int
+	}{
+		{"https://api.deezer.com/search/track?q=daft&index=25", 0, 25},
+		{"https://api.deezer.com/playlist/1/tracks?limit=100&index=300", 0, 300},
+		{"https://api.deezer.com/search/track?q=daft", 40, 40},
+		{"https://api.deezer.com/search/track?index=abc", 40, 40},
+		{"https://api.deezer.com/search/track?index=0", 40, 40},
+		{"://bad", 7, 7},
+	}
+
+	for _, tt := range tests {
+		if got := nextIndex(tt.next, tt.fallback); got != tt.want {
+			t.Errorf("nextIndex(%q, %d) = %d, want %d", tt.next, tt.fallback, got, tt.want)
+		}
+	}
+}
+
+func TestPagerFetchesLazily(t *testing.T) {
+	server := &pagedServer{total: 250}
+	client := newTestClient(t, server)
+	pager := newPager[item](client, "/items", nil, 0, 0)
+
+	if server.requestCount() != 0 {
+		t.Fatal("pager fetched before Next was called")
+	}
+	if pager.Total() != 0 {
+		t.Errorf("Total before the first page = %d, want 0", pager.Total())
+	}
+
+	for i := 1; i <= 101; i++ {
+		it, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next #%d: %v", i, err)
+		}
+		if it.ID != int64(i) {
+			t.Fatalf("Next #%d returned ID %d", i, it.ID)
+		}
+
+		wantRequests := 1
+		if i > 100 {
+			wantRequests = 2
+		}
+		if got := server.requestCount(); got != wantRequests {
+			t.Fatalf("after %d items made %d requests, want %d", i, got, wantRequests)
+		}
+	}
+
+	if pager.Total() != 250 {
+		t.Errorf("Total = %d, want 250", pager.Total())
+	}
+}
+
+func TestPagerStopsAtLimitAndEnd(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+		limit int
+		want  int
+	}{
+		{"limit", 250, 42, 42},
+		{"end of collection", 30, 0, 30},
+		{"empty", 0, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, &pagedServer{total: tt.total})
+			pager := newPager[item](client, "/items", nil, tt.limit, 0)
+
+			count := 0
+			for {
+				_, err := pager.Next(context.Background())
+				if errors.Is(err, ErrDone) {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				count++
+			}
+
+			if count != tt.want {
+				t.Errorf("got %d items, want %d", count, tt.want)
+			}
+
+			// A finished pager keeps reporting ErrDone.
+			if _, err := pager.Next(context.Background()); !errors.Is(err, ErrDone) {
+				t.Errorf("Next after the end = %v, want ErrDone", err)
+			}
+		})
+	}
+}
+
+func TestPagerReturnsErrors(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": {"type": "DataException", "message": "no data", "code": 800}}`))
+	}))
+	pager := newPager[item](client, "/items", nil, 0, 0)
+
+	_, err := pager.Next(context.Background())
+	if err == nil || errors.Is(err, ErrDone) {
+		t.Fatalf("Next = %v, want an API error", err)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("Next = %v, want a not-found error", err)
+	}
+}
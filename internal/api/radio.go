@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) GetRadios(limit int) (*RadiosResult, error) {
+	return c.GetRadiosContext(context.Background(), limit)
+}
+
+func (c *Client) GetRadiosContext(ctx context.Context, limit int) (*RadiosResult, error) {
+	result, err := fetchPages[Radio](ctx, c, "/radio", url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RadiosResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetRadio(id int64) (*Radio, error) {
+	return c.GetRadioContext(context.Background(), id)
+}
+
+func (c *Client) GetRadioContext(ctx context.Context, id int64) (*Radio, error) {
+	endpoint := fmt.Sprintf("/radio/%d", id)
+	data, err := c.getContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var radio Radio
+	if err := json.Unmarshal(data, &radio); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &radio, nil
+}
+
+func (c *Client) GetRadioTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetRadioTracksContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetRadioTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
+	endpoint := fmt.Sprintf("/radio/%d/tracks", id)
+
+	result, err := fetchPages[Track](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetArtistRadio(id int64, limit int) (*TracksResult, error) {
+	return c.GetArtistRadioContext(context.Background(), id, limit)
+}
+
+// GetArtistRadioContext returns the mix of tracks Deezer generates from an
+// artist and similar artists.
+func (c *Client) GetArtistRadioContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
+	endpoint := fmt.Sprintf("/artist/%d/radio", id)
+
+	result, err := fetchPages[Track](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
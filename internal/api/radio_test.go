@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRadioEndpoints(t *testing.T) {
+	runEndpointTests(t, []endpointTest{
+		{"/radio", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetRadiosContext(ctx, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/radio/37151/tracks", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetRadioTracksContext(ctx, 37151, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/artist/27/radio", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetArtistRadioContext(ctx, 27, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+	})
+}
+
+func TestGetRadio(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/radio/37151" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(Radio{ID: 37151, Title: "Mix"})
+	}))
+
+	radio, err := client.GetRadioContext(context.Background(), 37151)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if radio.GetID() != 37151 || radio.GetTitle() != "Mix" {
+		t.Errorf("radio = %d %q, want 37151 \"Mix\"", radio.GetID(), radio.GetTitle())
+	}
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const (
+	DefaultCrawlDepth    = 1
+	DefaultCrawlMaxNodes = 100
+)
+
+// RelatedArtist is an artist reached while crawling the related-artist
+// graph, Depth hops away from the root through the artist ParentID.
+type RelatedArtist struct {
+	Artist
+	Depth    int   `json:"depth"`
+	ParentID int64 `json:"parent_id,omitempty"`
+}
+
+// ArtistEdge links an artist to one of its related artists. Hop is the
+// crawl level the edge was found at: 1 for the root's related artists.
+// Weight decreases with the hop, from the crawl depth down to 1, so edges
+// near the root weigh the most.
+type ArtistEdge struct {
+	Source int64 `json:"source"`
+	Target int64 `json:"target"`
+	Hop    int   `json:"hop"`
+	Weight int   `json:"weight"`
+}
+
+// ArtistGraph is the neighborhood of an artist. Nodes are in breadth-first
+// order, starting with the root at depth 0. Failed lists the artists whose
+// related artists could not be fetched; the crawl continues without them.
+type ArtistGraph struct {
+	Nodes  []RelatedArtist `json:"nodes"`
+	Edges  []ArtistEdge    `json:"edges"`
+	Failed []CrawlError    `json:"-"`
+}
+
+// CrawlError records why the related artists of ArtistID were not fetched.
+type CrawlError struct {
+	ArtistID int64
+	Err      error
+}
+
+func (e CrawlError) Error() string {
+	return fmt.Sprintf("artist %d: %v", e.ArtistID, e.Err)
+}
+
+func (e CrawlError) Unwrap() error {
+	return e.Err
+}
+
+// CrawlOptions bounds a related-artist crawl. Zero values select the
+// defaults; a negative MaxNodes disables the node cap.
+type CrawlOptions struct {
+	// Depth is the number of hops to follow from the root.
+	Depth int
+	// MaxNodes caps the number of artists discovered, excluding the root.
+	MaxNodes int
+	// PerArtist limits the related artists fetched for each artist; 0
+	// fetches all of them.
+	PerArtist int
+}
+
+func (c *Client) GetRelatedArtists(id int64, limit int) (*ArtistsResult, error) {
+	return c.GetRelatedArtistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetRelatedArtistsContext(ctx context.Context, id int64, limit int) (*ArtistsResult, error) {
+	endpoint := fmt.Sprintf("/artist/%d/related", id)
+
+	result, err := fetchPages[Artist](ctx, c, endpoint, url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) CrawlRelatedArtists(id int64, opts CrawlOptions) (*ArtistGraph, error) {
+	return c.CrawlRelatedArtistsContext(context.Background(), id, opts)
+}
+
+// CrawlRelatedArtistsContext walks the related-artist graph breadth-first
+// from the artist id. Each level is fetched concurrently through the batch
+// worker pool; artists are visited once, and the crawl stops adding
+// artists once MaxNodes have been found. Edges between artists already in
+// the graph are still recorded. An artist whose related artists cannot be
+// fetched is recorded in Failed and the rest of the graph is returned;
+// only a failure for the root itself or a canceled ctx is an error.
+func (c *Client) CrawlRelatedArtistsContext(ctx context.Context, id int64, opts CrawlOptions) (*ArtistGraph, error) {
+	depth := opts.Depth
+	if depth <= 0 {
+		depth = DefaultCrawlDepth
+	}
+
+	maxNodes := opts.MaxNodes
+	if maxNodes == 0 {
+		maxNodes = DefaultCrawlMaxNodes
+	}
+
+	root, err := c.GetArtistContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &ArtistGraph{Nodes: []RelatedArtist{{Artist: *root}}}
+	seen := map[int64]bool{root.ID: true}
+	edges := map[[2]int64]bool{}
+	full := func() bool { return maxNodes > 0 && len(graph.Nodes)-1 >= maxNodes }
+
+	frontier := []int64{root.ID}
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		results := Batch(ctx, c, frontier, func(ctx context.Context, id int64) (*ArtistsResult, error) {
+			return c.GetRelatedArtistsContext(ctx, id, opts.PerArtist)
+		})
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var next []int64
+		for _, result := range results {
+			if result.Err != nil {
+				if hop == 1 {
+					return nil, result.Err
+				}
+				graph.Failed = append(graph.Failed, CrawlError{ArtistID: result.ID, Err: result.Err})
+				continue
+			}
+
+			for _, artist := range result.Item.Data {
+				if !seen[artist.ID] {
+					if full() {
+						continue
+					}
+					seen[artist.ID] = true
+					graph.Nodes = append(graph.Nodes, RelatedArtist{Artist: artist, Depth: hop, ParentID: result.ID})
+					next = append(next, artist.ID)
+				}
+
+				key := [2]int64{result.ID, artist.ID}
+				if !edges[key] {
+					edges[key] = true
+					graph.Edges = append(graph.Edges, ArtistEdge{
+						Source: result.ID,
+						Target: artist.ID,
+						Hop:    hop,
+						Weight: depth - hop + 1,
+					})
+				}
+			}
+		}
+
+		frontier = next
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// relatedServer serves artists whose related artists are given by an
+// adjacency list; artists listed in broken answer with a server error.
+func relatedServer(related map[int64][]int64, broken map[int64]bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id int64
+		if _, err := fmt.Sscanf(r.URL.Path, "/artist/%d", &id); err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		if !strings.HasSuffix(r.URL.Path, "/related") {
+			json.NewEncoder(w).Encode(Artist{ID: id, Name: fmt.Sprint("artist ", id)})
+			return
+		}
+
+		if broken[id] {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var response page[Artist]
+		for _, relatedID := range related[id] {
+			response.Data = append(response.Data, Artist{ID: relatedID})
+		}
+		response.Total = len(response.Data)
+		json.NewEncoder(w).Encode(response)
+	})
+}
+
+func TestCrawlRelatedArtists(t *testing.T) {
+	related := map[int64][]int64{
+		1: {2, 3},
+		2: {1, 4},
+		3: {4, 5},
+	}
+	client := newTestClient(t, relatedServer(related, nil))
+
+	graph, err := client.CrawlRelatedArtistsContext(context.Background(), 1, CrawlOptions{Depth: 2, MaxNodes: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int64
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5]" {
+		t.Errorf("nodes = %v, want [1 2 3 4 5]", ids)
+	}
+	if len(graph.Edges) != 6 {
+		t.Errorf("got %d edges, want 6", len(graph.Edges))
+	}
+	for _, edge := range graph.Edges {
+		if want := 2 - edge.Hop + 1; edge.Weight != want {
+			t.Errorf("edge %d->%d at hop %d has weight %d, want %d", edge.Source, edge.Target, edge.Hop, edge.Weight, want)
+		}
+	}
+}
+
+func TestCrawlRelatedArtistsMaxNodes(t *testing.T) {
+	related := map[int64][]int64{1: {2, 3, 4, 5}}
+	client := newTestClient(t, relatedServer(related, nil))
+
+	graph, err := client.CrawlRelatedArtistsContext(context.Background(), 1, CrawlOptions{MaxNodes: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.Nodes) != 3 {
+		t.Errorf("got %d nodes, want the root and 2 related artists", len(graph.Nodes))
+	}
+}
+
+func TestCrawlRelatedArtistsKeepsPartialGraph(t *testing.T) {
+	related := map[int64][]int64{
+		1: {2, 3},
+		3: {4},
+	}
+	client := newTestClient(t, relatedServer(related, map[int64]bool{2: true}))
+	client.maxRetries = 0
+
+	graph, err := client.CrawlRelatedArtistsContext(context.Background(), 1, CrawlOptions{Depth: 2, MaxNodes: -1})
+	if err != nil {
+		t.Fatalf("crawl failed instead of skipping the broken artist: %v", err)
+	}
+	if len(graph.Nodes) != 4 {
+		t.Errorf("got %d nodes, want 4", len(graph.Nodes))
+	}
+	if len(graph.Failed) != 1 || graph.Failed[0].ArtistID != 2 {
+		t.Errorf("Failed = %v, want artist 2", graph.Failed)
+	}
+}
+
+func TestCrawlRelatedArtistsRootFailure(t *testing.T) {
+	client := newTestClient(t, relatedServer(nil, map[int64]bool{1: true}))
+	client.maxRetries = 0
+
+	if _, err := client.CrawlRelatedArtistsContext(context.Background(), 1, CrawlOptions{}); err == nil {
+		t.Error("crawl succeeded although the root's related artists failed")
+	}
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Resource identifies a Deezer entity by type and ID.
+type Resource struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+	URL  string `json:"url"`
+}
+
+// ErrNotDeezerURL is returned when a URL does not point to a Deezer entity.
+var ErrNotDeezerURL = errors.New("not a Deezer URL")
+
+// resourceTypes maps URL path segments to resource types.
+var resourceTypes = map[string]string{
+	"track":    "track",
+	"album":    "album",
+	"artist":   "artist",
+	"playlist": "playlist",
+	"show":     "show",
+	"podcast":  "show",
+	"episode":  "episode",
+	"profile":  "user",
+	"user":     "user",
+}
+
+var shortLinkHosts = []string{"deezer.page.link", "dzr.page.link", "link.deezer.com"}
+
+// embeddedURL finds a Deezer URL in a short-link landing page that
+// redirects with HTML or JavaScript rather than a Location header.
+var embeddedURL = regexp.MustCompile(`https?:(?:\\?/){2}(?:www\.)?deezer\.com(?:\\?/[A-Za-z0-9_-]+)+`)
+
+const maxRedirects = 10
+
+// ParseURL extracts the resource type and ID from a Deezer URL such as
+// https://www.deezer.com/en/album/302127, deezer.com/us/track/3135556 or
+// https://api.deezer.com/artist/27. Short links must be resolved with
+// Client.ResolveURL.
+func ParseURL(raw string) (Resource, error) {
+	u, err := parseLooseURL(raw)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host != "deezer.com" && !strings.HasSuffix(host, ".deezer.com") {
+		return Resource{}, fmt.Errorf("%w: %s", ErrNotDeezerURL, raw)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		resourceType, ok := resourceTypes[strings.ToLower(segments[i])]
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.ParseInt(segments[i+1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		return Resource{Type: resourceType, ID: id, URL: u.String()}, nil
+	}
+
+	return Resource{}, fmt.Errorf("%w: no track, album, artist, playlist, show, episode or user in %s", ErrNotDeezerURL, raw)
+}
+
+// IsShortLink reports whether raw is a Deezer share link that needs to be
+// followed before it can be parsed.
+func IsShortLink(raw string) bool {
+	u, err := parseLooseURL(raw)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, shortHost := range shortLinkHosts {
+		if host == shortHost {
+			return true
+		}
+	}
+	return false
+}
+
+// IsURL reports whether s looks like a URL rather than a numeric ID.
+func IsURL(s string) bool {
+	return strings.Contains(s, "/")
+}
+
+func parseLooseURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+	return u, nil
+}
+
+func (c *Client) ResolveURL(raw string) (Resource, error) {
+	return c.ResolveURLContext(context.Background(), raw)
+}
+
+// ResolveURLContext resolves any Deezer URL form, following the redirects
+// of share links like https://deezer.page.link/... to the entity they
+// point to. Resolved short links are cached like API responses.
+func (c *Client) ResolveURLContext(ctx context.Context, raw string) (Resource, error) {
+	if !IsShortLink(raw) {
+		return ParseURL(raw)
+	}
+
+	cacheKey := "resolve:" + strings.TrimSpace(raw)
+	if c.cache != nil {
+		var resource Resource
+		if c.cache.Get(cacheKey, &resource) {
+			return resource, nil
+		}
+	}
+
+	resource, err := c.followShortLink(ctx, raw)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	if c.cache != nil {
+		c.cache.Set(cacheKey, resource)
+	}
+	return resource, nil
+}
+
+func (c *Client) followShortLink(ctx context.Context, raw string) (Resource, error) {
+	u, err := parseLooseURL(raw)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	var resource Resource
+	var found bool
+
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		if r, err := ParseURL(req.URL.String()); err == nil {
+			// No need to load the Deezer page itself.
+			resource, found = r, true
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Resource{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return Resource{}, ctx.Err()
+		}
+		return Resource{}, fmt.Errorf("failed to follow %s: %w", raw, err)
+	}
+	defer resp.Body.Close()
+
+	if found {
+		return resource, nil
+	}
+
+	if r, err := ParseURL(resp.Request.URL.String()); err == nil {
+		return r, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Resource{}, fmt.Errorf("failed to read %s: %w", raw, err)
+	}
+
+	for _, match := range embeddedURL.FindAllString(string(body), -1) {
+		if r, err := ParseURL(strings.ReplaceAll(match, `\/`, "/")); err == nil {
+			return r, nil
+		}
+	}
+
+	return Resource{}, fmt.Errorf("%w: %s does not redirect to a Deezer entity", ErrNotDeezerURL, raw)
+}
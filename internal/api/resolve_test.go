@@ -0,0 +1,80 @@
+package api
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		raw      string
+		wantType string
+		wantID   int64
+	}{
+		{"https://www.deezer.com/en/album/302127", "album", 302127},
+		{"https://www.deezer.com/track/3135556", "track", 3135556},
+		{"deezer.com/us/track/3135556", "track", 3135556},
+		{"  https://deezer.com/fr/artist/27  ", "artist", 27},
+		{"https://api.deezer.com/artist/27", "artist", 27},
+		{"https://www.deezer.com/en/playlist/908622995?utm_source=share", "playlist", 908622995},
+		{"https://www.deezer.com/en/show/406562", "show", 406562},
+		{"https://www.deezer.com/podcast/406562", "show", 406562},
+		{"https://www.deezer.com/en/episode/789012", "episode", 789012},
+		{"https://www.deezer.com/en/profile/5", "user", 5},
+		{"https://api.deezer.com/user/5", "user", 5},
+		{"https://WWW.DEEZER.COM/EN/ALBUM/302127", "album", 302127},
+		{"https://www.deezer.com/en/artist/27/top_track", "artist", 27},
+	}
+
+	for _, tt := range tests {
+		resource, err := ParseURL(tt.raw)
+		if err != nil {
+			t.Errorf("ParseURL(%q): %v", tt.raw, err)
+			continue
+		}
+		if resource.Type != tt.wantType || resource.ID != tt.wantID {
+			t.Errorf("ParseURL(%q) = %s %d, want %s %d", tt.raw, resource.Type, resource.ID, tt.wantType, tt.wantID)
+		}
+	}
+}
+
+func TestParseURLRejects(t *testing.T) {
+	tests := []string{
+		"https://open.spotify.com/track/3135556",
+		"https://notdeezer.com/track/3135556",
+		"https://deezer.com.evil.example/track/1",
+		"https://www.deezer.com/en/",
+		"https://www.deezer.com/en/album/abc",
+		"https://www.deezer.com/en/lyrics/3135556",
+		"https://deezer.page.link/abc123",
+	}
+
+	for _, raw := range tests {
+		if _, err := ParseURL(raw); !errors.Is(err, ErrNotDeezerURL) {
+			t.Errorf("ParseURL(%q) = %v, want ErrNotDeezerURL", raw, err)
+		}
+	}
+}
+
+func TestIsShortLink(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{"https://deezer.page.link/abc123", true},
+		{"deezer.page.link/abc123", true},
+		{"https://dzr.page.link/abc123", true},
+		{"https://link.deezer.com/s/abc123", true},
+		{"https://DEEZER.PAGE.LINK/abc123", true},
+		{"https://www.deezer.com/en/track/3135556", false},
+		{"https://page.link/abc123", false},
+		{"https://evil.deezer.page.link.example/abc", false},
+		{"3135556", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsShortLink(tt.raw); got != tt.want {
+			t.Errorf("IsShortLink(%q) = %t, want %t", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// Deezer allows 50 requests per 5 seconds, so a quota error clears
+	// after at most one window.
+	quotaWindow = 5 * time.Second
+
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+type retryableError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// backoff returns the delay before the given retry attempt: exponential
+// growth from the base delay with jitter, capped at the maximum delay and
+// never shorter than a server-provided Retry-After.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := c.retryBaseDelay << uint(attempt)
+	if delay <= 0 || delay > c.retryMaxDelay {
+		delay = c.retryMaxDelay
+	}
+
+	// Equal jitter: keep half of the delay and randomize the rest.
+	half := delay / 2
+	delay = half + time.Duration(rand.Int63n(int64(half)+1))
+
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+
+	return delay
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	client := &Client{
+		retryBaseDelay: 100 * time.Millisecond,
+		retryMaxDelay:  time.Second,
+	}
+
+	tests := []struct {
+		attempt    int
+		retryAfter time.Duration
+		min, max   time.Duration
+	}{
+		{0, 0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 0, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 0, 400 * time.Millisecond, 800 * time.Millisecond},
+		{4, 0, 500 * time.Millisecond, time.Second},
+		{10, 0, 500 * time.Millisecond, time.Second},
+		// Shifting far enough overflows; the cap must still apply.
+		{70, 0, 500 * time.Millisecond, time.Second},
+		{0, 3 * time.Second, 3 * time.Second, 3 * time.Second},
+		{3, 10 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 50; i++ {
+			got := client.backoff(tt.attempt, tt.retryAfter)
+			if got < tt.min || got > tt.max {
+				t.Fatalf("backoff(%d, %v) = %v, want within [%v, %v]", tt.attempt, tt.retryAfter, got, tt.min, tt.max)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value    string
+		min, max time.Duration
+	}{
+		{"", 0, 0},
+		{"5", 5 * time.Second, 5 * time.Second},
+		{"0", 0, 0},
+		{"-3", 0, 0},
+		{"soon", 0, 0},
+		{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+	}
+
+	for _, tt := range tests {
+		got := parseRetryAfter(tt.value)
+		if got < tt.min || got > tt.max {
+			t.Errorf("parseRetryAfter(%q) = %v, want within [%v, %v]", tt.value, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestGetRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		respond  func(w http.ResponseWriter)
+		retries  int
+		wantErr  bool
+		attempts int32
+	}{
+		{
+			name: "server error then success",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			retries:  3,
+			attempts: 2,
+		},
+		{
+			name: "rate limited then success",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			retries:  3,
+			attempts: 2,
+		},
+		{
+			name: "service busy then success",
+			respond: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"error": {"type": "Exception", "message": "busy", "code": 700}}`))
+			},
+			retries:  3,
+			attempts: 2,
+		},
+		{
+			name: "not found is not retried",
+			respond: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"error": {"type": "DataException", "message": "no data", "code": 800}}`))
+			},
+			retries:  3,
+			wantErr:  true,
+			attempts: 1,
+		},
+		{
+			name: "retries disabled",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			retries:  0,
+			wantErr:  true,
+			attempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Fail on the first attempt only.
+				if attempts.Add(1) == 1 {
+					tt.respond(w)
+					return
+				}
+				w.Write([]byte(`{"id": 1}`))
+			}))
+			client.maxRetries = tt.retries
+
+			_, err := client.getContext(context.Background(), "/track/1", nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getContext() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.attempts {
+				t.Errorf("made %d attempts, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestGetGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	client.maxRetries = 2
+
+	_, err := client.getContext(context.Background(), "/track/1", nil)
+	if err == nil {
+		t.Fatal("getContext() succeeded, want an error")
+	}
+	if _, ok := err.(*retryableError); ok {
+		t.Errorf("getContext() leaked the internal retry wrapper: %v", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("made %d attempts, want 3", got)
+	}
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// UserChartTypes lists the collections a user's charts cover.
+var UserChartTypes = []string{"tracks", "albums", "artists", "playlists"}
+
+func (c *Client) GetUser(id int64) (*User, error) {
+	return c.GetUserContext(context.Background(), id)
+}
+
+func (c *Client) GetUserContext(ctx context.Context, id int64) (*User, error) {
+	endpoint := fmt.Sprintf("/user/%d", id)
+	data, err := c.getContext(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (c *Client) GetUserPlaylists(id int64, limit int) (*PlaylistsResult, error) {
+	return c.GetUserPlaylistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetUserPlaylistsContext(ctx context.Context, id int64, limit int) (*PlaylistsResult, error) {
+	result, err := fetchPages[Playlist](ctx, c, fmt.Sprintf("/user/%d/playlists", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlaylistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserAlbums(id int64, limit int) (*AlbumsResult, error) {
+	return c.GetUserAlbumsContext(context.Background(), id, limit)
+}
+
+// GetUserAlbumsContext returns the user's favorite albums.
+func (c *Client) GetUserAlbumsContext(ctx context.Context, id int64, limit int) (*AlbumsResult, error) {
+	result, err := fetchPages[Album](ctx, c, fmt.Sprintf("/user/%d/albums", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlbumsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserArtists(id int64, limit int) (*ArtistsResult, error) {
+	return c.GetUserArtistsContext(context.Background(), id, limit)
+}
+
+// GetUserArtistsContext returns the user's favorite artists.
+func (c *Client) GetUserArtistsContext(ctx context.Context, id int64, limit int) (*ArtistsResult, error) {
+	result, err := fetchPages[Artist](ctx, c, fmt.Sprintf("/user/%d/artists", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetUserTracksContext(context.Background(), id, limit)
+}
+
+// GetUserTracksContext returns the user's favorite tracks.
+func (c *Client) GetUserTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
+	result, err := fetchPages[Track](ctx, c, fmt.Sprintf("/user/%d/tracks", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserFollowings(id int64, limit int) (*UsersResult, error) {
+	return c.GetUserFollowingsContext(context.Background(), id, limit)
+}
+
+// GetUserFollowingsContext returns the users the user follows.
+func (c *Client) GetUserFollowingsContext(ctx context.Context, id int64, limit int) (*UsersResult, error) {
+	result, err := fetchPages[User](ctx, c, fmt.Sprintf("/user/%d/followings", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsersResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserFollowers(id int64, limit int) (*UsersResult, error) {
+	return c.GetUserFollowersContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetUserFollowersContext(ctx context.Context, id int64, limit int) (*UsersResult, error) {
+	result, err := fetchPages[User](ctx, c, fmt.Sprintf("/user/%d/followers", id), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsersResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func userChartEndpoint(id int64, kind string) string {
+	return fmt.Sprintf("/user/%d/charts/%s", id, kind)
+}
+
+func (c *Client) GetUserChartTracks(id int64, limit int) (*TracksResult, error) {
+	return c.GetUserChartTracksContext(context.Background(), id, limit)
+}
+
+// GetUserChartTracksContext returns the tracks the user listens to most.
+func (c *Client) GetUserChartTracksContext(ctx context.Context, id int64, limit int) (*TracksResult, error) {
+	result, err := fetchPages[Track](ctx, c, userChartEndpoint(id, "tracks"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TracksResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserChartAlbums(id int64, limit int) (*AlbumsResult, error) {
+	return c.GetUserChartAlbumsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetUserChartAlbumsContext(ctx context.Context, id int64, limit int) (*AlbumsResult, error) {
+	result, err := fetchPages[Album](ctx, c, userChartEndpoint(id, "albums"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlbumsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserChartArtists(id int64, limit int) (*ArtistsResult, error) {
+	return c.GetUserChartArtistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetUserChartArtistsContext(ctx context.Context, id int64, limit int) (*ArtistsResult, error) {
+	result, err := fetchPages[Artist](ctx, c, userChartEndpoint(id, "artists"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
+
+func (c *Client) GetUserChartPlaylists(id int64, limit int) (*PlaylistsResult, error) {
+	return c.GetUserChartPlaylistsContext(context.Background(), id, limit)
+}
+
+func (c *Client) GetUserChartPlaylistsContext(ctx context.Context, id int64, limit int) (*PlaylistsResult, error) {
+	result, err := fetchPages[Playlist](ctx, c, userChartEndpoint(id, "playlists"), url.Values{}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlaylistsResult{Data: result.Data, Total: result.Total, Next: result.Next}, nil
+}
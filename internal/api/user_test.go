@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUserEndpoints(t *testing.T) {
+	runEndpointTests(t, []endpointTest{
+		{"/user/5/playlists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserPlaylistsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/albums", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserAlbumsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/artists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserArtistsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/tracks", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserTracksContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/followings", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserFollowingsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/followers", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserFollowersContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/charts/tracks", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserChartTracksContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/charts/albums", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserChartAlbumsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/charts/artists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserChartArtistsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+		{"/user/5/charts/playlists", func(ctx context.Context, c *Client) (int, error) {
+			result, err := c.GetUserChartPlaylistsContext(ctx, 5, 10)
+			if err != nil {
+				return 0, err
+			}
+			return len(result.Data), nil
+		}},
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/5" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(User{ID: 5, Name: "Daniel"})
+	}))
+
+	user, err := client.GetUserContext(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 5 || user.Name != "Daniel" {
+		t.Errorf("user = %d %q, want 5 \"Daniel\"", user.ID, user.Name)
+	}
+
+	_, err = client.GetUserContext(context.Background(), 6)
+	if !IsNotFound(err) {
+		t.Errorf("unknown user error = %v, want not found", err)
+	}
+}
@@ -1,42 +1,444 @@
 package cache
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/felipemarinho97/deezer-cli/internal/filelock"
 	gocache "github.com/patrickmn/go-cache"
 )
 
+const (
+	entryExt  = ".json"
+	statsFile = "stats"
+)
+
 type Cache struct {
 	store *gocache.Cache
+	dir   string
+	ttl   time.Duration
+}
+
+// Entry is a cached response. ExpiresAt is derived from CreatedAt and the
+// TTL of the Cache that read the entry, so a lower TTL also applies to
+// entries written under a longer one.
+type Entry struct {
+	Key       string          `json:"key"`
+	CreatedAt time.Time       `json:"created_at"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	Size      int64           `json:"-"`
+}
+
+func (e Entry) Expired() bool {
+	return time.Now().After(e.ExpiresAt)
+}
+
+type Stats struct {
+	Dir     string    `json:"dir"`
+	Entries int       `json:"entries"`
+	Expired int       `json:"expired"`
+	Size    int64     `json:"size_bytes"`
+	Hits    int64     `json:"hits"`
+	Misses  int64     `json:"misses"`
+	Oldest  time.Time `json:"oldest,omitempty"`
+	Newest  time.Time `json:"newest,omitempty"`
+}
+
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type counters struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
 }
 
 func New(defaultExpiration, cleanupInterval time.Duration) *Cache {
 	return &Cache{
 		store: gocache.New(defaultExpiration, cleanupInterval),
+		ttl:   defaultExpiration,
+	}
+}
+
+// NewDisk returns a cache that persists entries as files under dir so they
+// can be shared between processes, with an in-memory layer in front.
+func NewDisk(dir string, ttl time.Duration) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &Cache{
+		store: gocache.New(ttl, ttl*2),
+		dir:   dir,
+		ttl:   ttl,
+	}, nil
+}
+
+// DefaultDir returns the per-user cache directory, honoring XDG_CACHE_HOME.
+func DefaultDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
 	}
+
+	return filepath.Join(base, "deezer-cli"), nil
+}
+
+func (c *Cache) Dir() string {
+	return c.dir
 }
 
 func (c *Cache) Set(key string, value interface{}) {
+	// go-cache keeps entries with a zero duration forever, so a zero TTL
+	// must not reach it.
+	if c.ttl <= 0 {
+		return
+	}
+
 	data, err := json.Marshal(value)
-	if err == nil {
-		c.store.SetDefault(key, data)
+	if err != nil {
+		return
+	}
+
+	c.store.SetDefault(key, data)
+
+	if c.dir != "" {
+		now := time.Now()
+		c.writeEntry(&Entry{
+			Key:       key,
+			CreatedAt: now,
+			ExpiresAt: now.Add(c.ttl),
+			Value:     data,
+		})
 	}
 }
 
 func (c *Cache) Get(key string, target interface{}) bool {
+	if c.ttl <= 0 {
+		c.record(false)
+		return false
+	}
+
 	if data, found := c.store.Get(key); found {
 		if bytes, ok := data.([]byte); ok {
-			return json.Unmarshal(bytes, target) == nil
+			hit := json.Unmarshal(bytes, target) == nil
+			c.record(hit)
+			return hit
+		}
+	}
+
+	if c.dir == "" {
+		return false
+	}
+
+	e, err := c.readEntry(c.path(key))
+	if err != nil || e.Key != key {
+		c.record(false)
+		return false
+	}
+
+	if e.Expired() {
+		os.Remove(c.path(key))
+		c.record(false)
+		return false
+	}
+
+	if remaining := time.Until(e.ExpiresAt); remaining > 0 {
+		c.store.Set(key, []byte(e.Value), remaining)
+	}
+
+	if json.Unmarshal(e.Value, target) != nil {
+		c.record(false)
+		return false
+	}
+
+	c.record(true)
+	return true
+}
+
+// Lookup returns the stored entry for key without checking its expiry.
+func (c *Cache) Lookup(key string) (*Entry, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+
+	e, err := c.readEntry(c.path(key))
+	if err != nil || e.Key != key {
+		return nil, false
+	}
+
+	return e, true
+}
+
+// Entries lists the stored entries, without their values, oldest first.
+func (c *Cache) Entries() ([]Entry, error) {
+	if c.dir == "" {
+		return nil, nil
+	}
+
+	files, err := c.entryFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, file := range files {
+		e, err := c.readEntry(file)
+		if err != nil {
+			continue
+		}
+		e.Value = nil
+		entries = append(entries, *e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	return entries, nil
+}
+
+func (c *Cache) Stats() (*Stats, error) {
+	entries, err := c.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{Dir: c.dir}
+	for _, e := range entries {
+		stats.Entries++
+		stats.Size += e.Size
+		if e.Expired() {
+			stats.Expired++
+		}
+		if stats.Oldest.IsZero() || e.CreatedAt.Before(stats.Oldest) {
+			stats.Oldest = e.CreatedAt
+		}
+		if e.CreatedAt.After(stats.Newest) {
+			stats.Newest = e.CreatedAt
+		}
+	}
+
+	counts := c.readCounters()
+	stats.Hits = counts.Hits
+	stats.Misses = counts.Misses
+
+	return stats, nil
+}
+
+// Removal reports the entries removed by Purge or Prune. Corrupt counts
+// unreadable entry files, which are only removed by Prune and a full Purge.
+type Removal struct {
+	Removed int
+	Corrupt int
+}
+
+// Purge removes every entry whose key starts with prefix. An empty prefix
+// removes all entries, including corrupt ones, and resets the hit/miss
+// counters.
+func (c *Cache) Purge(prefix string) (Removal, error) {
+	all := prefix == ""
+	return c.remove(func(e *Entry) bool {
+		return strings.HasPrefix(e.Key, prefix)
+	}, all, all)
+}
+
+// Prune removes expired and corrupt entries.
+func (c *Cache) Prune() (Removal, error) {
+	return c.remove(func(e *Entry) bool {
+		return e.Expired()
+	}, true, false)
+}
+
+func (c *Cache) remove(match func(e *Entry) bool, removeCorrupt, resetCounters bool) (Removal, error) {
+	var removal Removal
+
+	c.store.Flush()
+
+	if c.dir == "" {
+		return removal, nil
+	}
+
+	files, err := c.entryFiles()
+	if err != nil {
+		return removal, err
+	}
+
+	for _, file := range files {
+		e, err := c.readEntry(file)
+		corrupt := err != nil
+		if corrupt && !removeCorrupt || !corrupt && !match(e) {
+			continue
 		}
+
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return removal, err
+		}
+		if corrupt {
+			removal.Corrupt++
+		} else {
+			removal.Removed++
+		}
+	}
+
+	if resetCounters {
+		os.Remove(filepath.Join(c.dir, statsFile))
 	}
-	return false
+
+	return removal, nil
 }
 
 func (c *Cache) Delete(key string) {
 	c.store.Delete(key)
+
+	if c.dir != "" {
+		os.Remove(c.path(key))
+	}
 }
 
 func (c *Cache) Flush() {
-	c.store.Flush()
-}
\ No newline at end of file
+	c.Purge("")
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+entryExt)
+}
+
+func (c *Cache) entryFiles() ([]string, error) {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), entryExt) {
+			continue
+		}
+		files = append(files, filepath.Join(c.dir, dirEntry.Name()))
+	}
+
+	return files, nil
+}
+
+func (c *Cache) readEntry(path string) (*Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	e.Size = int64(len(data))
+	e.ExpiresAt = e.CreatedAt.Add(c.ttl)
+
+	return &e, nil
+}
+
+func (c *Cache) writeEntry(e *Entry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	c.writeFile(c.path(e.Key), data)
+}
+
+func (c *Cache) readCounters() counters {
+	var counts counters
+
+	file, err := os.Open(filepath.Join(c.dir, statsFile))
+	if err != nil {
+		return counts
+	}
+	defer file.Close()
+
+	unlock, err := filelock.Lock(file)
+	if err != nil {
+		return counts
+	}
+	defer unlock()
+
+	if data, err := io.ReadAll(file); err == nil {
+		json.Unmarshal(data, &counts)
+	}
+
+	return counts
+}
+
+// record persists hit/miss counters next to the entries. The counters are
+// updated in place under a file lock so that concurrent workers and
+// processes do not lose each other's increments.
+func (c *Cache) record(hit bool) {
+	if c.dir == "" {
+		return
+	}
+
+	file, err := os.OpenFile(filepath.Join(c.dir, statsFile), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	unlock, err := filelock.Lock(file)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	var counts counters
+	if data, err := io.ReadAll(file); err == nil && len(data) > 0 {
+		// Corrupt counters simply start over.
+		json.Unmarshal(data, &counts)
+	}
+
+	if hit {
+		counts.Hits++
+	} else {
+		counts.Misses++
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		return
+	}
+	if err := file.Truncate(0); err != nil {
+		return
+	}
+	file.WriteAt(data, 0)
+}
+
+// writeFile writes through a temporary file and renames it into place so
+// concurrent readers never observe a partially written file.
+func (c *Cache) writeFile(path string, data []byte) {
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+
+	os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetRecordsMemoryHits(t *testing.T) {
+	c, err := NewDisk(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set("/track/1?", map[string]int{"id": 1})
+
+	var value map[string]int
+	for i := 0; i < 2; i++ {
+		if !c.Get("/track/1?", &value) {
+			t.Fatalf("Get #%d missed", i+1)
+		}
+	}
+	if c.Get("/track/2?", &value) {
+		t.Fatal("Get of an unknown key hit")
+	}
+
+	stats, err := c.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("hits/misses = %d/%d, want 2/1", stats.Hits, stats.Misses)
+	}
+}
+
+func TestPurgePrefixKeepsCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewDisk(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set("/album/1?", 1)
+	c.Set("/track/1?", 1)
+	corrupt := filepath.Join(dir, "corrupt"+entryExt)
+	if err := os.WriteFile(corrupt, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	removal, err := c.Purge("/album/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removal != (Removal{Removed: 1}) {
+		t.Errorf("Purge(prefix) = %+v, want 1 removed", removal)
+	}
+	if _, err := os.Stat(corrupt); err != nil {
+		t.Errorf("corrupt file removed by a prefix purge: %v", err)
+	}
+
+	removal, err = c.Prune()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removal != (Removal{Corrupt: 1}) {
+		t.Errorf("Prune() = %+v, want 1 corrupt", removal)
+	}
+
+	removal, err = c.Purge("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removal != (Removal{Removed: 1}) {
+		t.Errorf("Purge(\"\") = %+v, want 1 removed", removal)
+	}
+}
+
+func TestGetHonorsCurrentTTL(t *testing.T) {
+	dir := t.TempDir()
+
+	long, err := NewDisk(dir, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	long.Set("/track/1?", 1)
+
+	short, err := NewDisk(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	var value int
+	if short.Get("/track/1?", &value) {
+		t.Error("entry written under a long TTL was served after the current, shorter TTL")
+	}
+}
+
+func TestZeroTTLDisablesCaching(t *testing.T) {
+	for _, c := range []*Cache{New(0, 0), mustNewDisk(t, 0)} {
+		c.Set("/track/1?", 1)
+
+		var value int
+		if c.Get("/track/1?", &value) {
+			t.Errorf("cache in %q served an entry with a zero TTL", c.Dir())
+		}
+	}
+}
+
+func mustNewDisk(t *testing.T, ttl time.Duration) *Cache {
+	t.Helper()
+
+	c, err := NewDisk(t.TempDir(), ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestConcurrentCountersAreNotLost(t *testing.T) {
+	dir := t.TempDir()
+
+	const workers, gets = 4, 25
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		// One cache per worker stands in for separate processes.
+		c, err := NewDisk(dir, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var value int
+			for i := 0; i < gets; i++ {
+				c.Get("/missing?", &value)
+			}
+		}()
+	}
+	wg.Wait()
+
+	c, err := NewDisk(dir, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := c.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Misses != workers*gets {
+		t.Errorf("Misses = %d, want %d", stats.Misses, workers*gets)
+	}
+}
@@ -5,13 +5,50 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
+const EnvPrefix = "DEEZER_CLI_"
+
+// Config holds the settings read from the config file. Country is only used
+// to report track availability and is never sent to the API.
 type Config struct {
-	DefaultFormat string `json:"default_format"`
-	DefaultLimit  int    `json:"default_limit"`
-	CacheEnabled  bool   `json:"cache_enabled"`
-	CacheTTL      int    `json:"cache_ttl_seconds"`
+	DefaultFormat string             `json:"default_format"`
+	DefaultLimit  int                `json:"default_limit"`
+	CacheEnabled  bool               `json:"cache_enabled"`
+	CacheTTL      int                `json:"cache_ttl_seconds"`
+	BaseURL       string             `json:"base_url,omitempty"`
+	Country       string             `json:"country,omitempty"`
+	Proxy         string             `json:"proxy,omitempty"`
+	Timeout       int                `json:"timeout_seconds"`
+	CABundle      string             `json:"ca_bundle,omitempty"`
+	UserAgent     string             `json:"user_agent,omitempty"`
+	MaxRetries    int                `json:"max_retries"`
+	RateRequests  int                `json:"rate_limit_requests"`
+	RatePeriod    int                `json:"rate_limit_period_seconds"`
+	RateShared    bool               `json:"rate_limit_shared"`
+	Concurrency   int                `json:"concurrency"`
+	Profiles      map[string]Profile `json:"profiles,omitempty"`
+}
+
+// Profile holds named overrides for Config. Unset fields keep the value
+// from the top level of the config file.
+type Profile struct {
+	DefaultFormat *string `json:"default_format,omitempty"`
+	DefaultLimit  *int    `json:"default_limit,omitempty"`
+	CacheEnabled  *bool   `json:"cache_enabled,omitempty"`
+	CacheTTL      *int    `json:"cache_ttl_seconds,omitempty"`
+	BaseURL       *string `json:"base_url,omitempty"`
+	Country       *string `json:"country,omitempty"`
+	Proxy         *string `json:"proxy,omitempty"`
+	Timeout       *int    `json:"timeout_seconds,omitempty"`
+	CABundle      *string `json:"ca_bundle,omitempty"`
+	UserAgent     *string `json:"user_agent,omitempty"`
+	MaxRetries    *int    `json:"max_retries,omitempty"`
+	RateRequests  *int    `json:"rate_limit_requests,omitempty"`
+	RatePeriod    *int    `json:"rate_limit_period_seconds,omitempty"`
+	RateShared    *bool   `json:"rate_limit_shared,omitempty"`
+	Concurrency   *int    `json:"concurrency,omitempty"`
 }
 
 var defaultConfig = Config{
@@ -19,37 +56,133 @@ var defaultConfig = Config{
 	DefaultLimit:  25,
 	CacheEnabled:  true,
 	CacheTTL:      300,
+	Timeout:       10,
+	MaxRetries:    3,
+	RateRequests:  50,
+	RatePeriod:    5,
+	Concurrency:   4,
 }
 
 func Load() (*Config, error) {
-	configPath := getConfigPath()
+	configPath := Path()
 	
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return &defaultConfig, nil
+		return Default(), nil
 	}
 
 	data, err := os.ReadFile(configPath)
 	if err != nil {
-		return &defaultConfig, fmt.Errorf("failed to read config: %w", err)
+		return Default(), fmt.Errorf("failed to read config: %w", err)
 	}
 
-	var config Config
+	config := defaultConfig
 	if err := json.Unmarshal(data, &config); err != nil {
-		return &defaultConfig, fmt.Errorf("failed to parse config: %w", err)
+		return Default(), fmt.Errorf("failed to parse config: %w", err)
 	}
 
 	if config.DefaultFormat == "" {
 		config.DefaultFormat = defaultConfig.DefaultFormat
 	}
-	if config.DefaultLimit == 0 {
-		config.DefaultLimit = defaultConfig.DefaultLimit
-	}
 
 	return &config, nil
 }
 
+func Default() *Config {
+	config := defaultConfig
+	return &config
+}
+
+// ApplyEnv overrides config values with DEEZER_CLI_* environment variables
+// named after the JSON keys, e.g. DEEZER_CLI_DEFAULT_FORMAT. Each value is
+// validated as it is applied so that errors name the variable at fault.
+func ApplyEnv(config *Config) error {
+	for _, key := range keys {
+		name := EnvPrefix + strings.ToUpper(key)
+		if value, ok := os.LookupEnv(name); ok {
+			if err := config.Set(key, value); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			if problem := config.problem(key); problem != "" {
+				return fmt.Errorf("%s: %s", name, problem)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ProfileFromEnv returns the profile selected by DEEZER_CLI_PROFILE.
+func ProfileFromEnv() string {
+	return os.Getenv(EnvPrefix + "PROFILE")
+}
+
+// Profile returns the named profile, or an error when it does not exist.
+func (c *Config) Profile(name string) (Profile, error) {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+
+	return profile, nil
+}
+
+func (c *Config) ApplyProfile(name string) error {
+	profile, err := c.Profile(name)
+	if err != nil {
+		return err
+	}
+
+	if profile.DefaultFormat != nil {
+		c.DefaultFormat = *profile.DefaultFormat
+	}
+	if profile.DefaultLimit != nil {
+		c.DefaultLimit = *profile.DefaultLimit
+	}
+	if profile.CacheEnabled != nil {
+		c.CacheEnabled = *profile.CacheEnabled
+	}
+	if profile.CacheTTL != nil {
+		c.CacheTTL = *profile.CacheTTL
+	}
+	if profile.BaseURL != nil {
+		c.BaseURL = *profile.BaseURL
+	}
+	if profile.Country != nil {
+		c.Country = *profile.Country
+	}
+	if profile.Proxy != nil {
+		c.Proxy = *profile.Proxy
+	}
+	if profile.Timeout != nil {
+		c.Timeout = *profile.Timeout
+	}
+	if profile.CABundle != nil {
+		c.CABundle = *profile.CABundle
+	}
+	if profile.UserAgent != nil {
+		c.UserAgent = *profile.UserAgent
+	}
+	if profile.MaxRetries != nil {
+		c.MaxRetries = *profile.MaxRetries
+	}
+	if profile.RateRequests != nil {
+		c.RateRequests = *profile.RateRequests
+	}
+	if profile.RatePeriod != nil {
+		c.RatePeriod = *profile.RatePeriod
+	}
+	if profile.RateShared != nil {
+		c.RateShared = *profile.RateShared
+	}
+	if profile.Concurrency != nil {
+		c.Concurrency = *profile.Concurrency
+	}
+
+	return nil
+}
+
 func Save(config *Config) error {
-	configPath := getConfigPath()
+	configPath := Path()
 	configDir := filepath.Dir(configPath)
 
 	if err := os.MkdirAll(configDir, 0755); err != nil {
@@ -68,7 +201,7 @@ func Save(config *Config) error {
 	return nil
 }
 
-func getConfigPath() string {
+func Path() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return ".deezer-cli-config.json"
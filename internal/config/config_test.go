@@ -0,0 +1,103 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeConfig(t *testing.T, data string) {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path := Path()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	writeConfig(t, `{}`)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*cfg, defaultConfig) {
+		t.Errorf("Load() = %+v, want defaults %+v", *cfg, defaultConfig)
+	}
+}
+
+func TestLoadKeepsZeroLimit(t *testing.T) {
+	writeConfig(t, `{"default_limit": 0}`)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultLimit != 0 {
+		t.Errorf("DefaultLimit = %d, want 0 (fetch all)", cfg.DefaultLimit)
+	}
+}
+
+func TestApplyProfile(t *testing.T) {
+	format := "json"
+	cfg := Default()
+	cfg.Profiles = map[string]Profile{"ci": {DefaultFormat: &format}}
+
+	if err := cfg.ApplyProfile("ci"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultFormat != "json" {
+		t.Errorf("DefaultFormat = %q, want %q", cfg.DefaultFormat, "json")
+	}
+	if cfg.DefaultLimit != defaultConfig.DefaultLimit {
+		t.Errorf("DefaultLimit = %d, want the top-level %d", cfg.DefaultLimit, defaultConfig.DefaultLimit)
+	}
+
+	if err := cfg.ApplyProfile("nosuch"); err == nil {
+		t.Error("ApplyProfile of an unknown profile succeeded")
+	}
+	if _, err := cfg.Profile("nosuch"); err == nil {
+		t.Error("Profile of an unknown profile succeeded")
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{"valid", map[string]string{"DEEZER_CLI_DEFAULT_FORMAT": "json", "DEEZER_CLI_TIMEOUT_SECONDS": "30"}, ""},
+		{"not an integer", map[string]string{"DEEZER_CLI_TIMEOUT_SECONDS": "x"}, `DEEZER_CLI_TIMEOUT_SECONDS: timeout_seconds must be an integer number of seconds, got "x"`},
+		{"out of range", map[string]string{"DEEZER_CLI_DEFAULT_LIMIT": "-1"}, "DEEZER_CLI_DEFAULT_LIMIT: default_limit must not be negative, got -1"},
+		{"unknown format", map[string]string{"DEEZER_CLI_DEFAULT_FORMAT": "xml"}, "DEEZER_CLI_DEFAULT_FORMAT: default_format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			err := ApplyEnv(Default())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ApplyEnv() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("ApplyEnv() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
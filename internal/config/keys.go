@@ -0,0 +1,466 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var Formats = []string{"table", "json", "csv", "yaml", "ids"}
+
+var keys = []string{
+	"default_format",
+	"default_limit",
+	"cache_enabled",
+	"cache_ttl_seconds",
+	"base_url",
+	"country",
+	"proxy",
+	"timeout_seconds",
+	"ca_bundle",
+	"user_agent",
+	"max_retries",
+	"rate_limit_requests",
+	"rate_limit_period_seconds",
+	"rate_limit_shared",
+	"concurrency",
+}
+
+func Keys() []string {
+	return append([]string(nil), keys...)
+}
+
+func (c *Config) Get(key string) (string, error) {
+	switch key {
+	case "default_format":
+		return c.DefaultFormat, nil
+	case "default_limit":
+		return strconv.Itoa(c.DefaultLimit), nil
+	case "cache_enabled":
+		return strconv.FormatBool(c.CacheEnabled), nil
+	case "cache_ttl_seconds":
+		return strconv.Itoa(c.CacheTTL), nil
+	case "base_url":
+		return c.BaseURL, nil
+	case "country":
+		return c.Country, nil
+	case "proxy":
+		return c.Proxy, nil
+	case "timeout_seconds":
+		return strconv.Itoa(c.Timeout), nil
+	case "ca_bundle":
+		return c.CABundle, nil
+	case "user_agent":
+		return c.UserAgent, nil
+	case "max_retries":
+		return strconv.Itoa(c.MaxRetries), nil
+	case "rate_limit_requests":
+		return strconv.Itoa(c.RateRequests), nil
+	case "rate_limit_period_seconds":
+		return strconv.Itoa(c.RatePeriod), nil
+	case "rate_limit_shared":
+		return strconv.FormatBool(c.RateShared), nil
+	case "concurrency":
+		return strconv.Itoa(c.Concurrency), nil
+	default:
+		return "", unknownKeyError(key)
+	}
+}
+
+func (c *Config) Set(key, value string) error {
+	switch key {
+	case "default_format":
+		c.DefaultFormat = value
+	case "default_limit":
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		c.DefaultLimit = limit
+	case "cache_enabled":
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s must be true or false, got %q", key, value)
+		}
+		c.CacheEnabled = enabled
+	case "cache_ttl_seconds":
+		ttl, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer number of seconds, got %q", key, value)
+		}
+		c.CacheTTL = ttl
+	case "base_url":
+		c.BaseURL = value
+	case "country":
+		c.Country = strings.ToUpper(value)
+	case "proxy":
+		c.Proxy = value
+	case "timeout_seconds":
+		timeout, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer number of seconds, got %q", key, value)
+		}
+		c.Timeout = timeout
+	case "ca_bundle":
+		c.CABundle = value
+	case "user_agent":
+		c.UserAgent = value
+	case "max_retries":
+		retries, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		c.MaxRetries = retries
+	case "rate_limit_requests":
+		requests, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		c.RateRequests = requests
+	case "rate_limit_period_seconds":
+		period, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer number of seconds, got %q", key, value)
+		}
+		c.RatePeriod = period
+	case "rate_limit_shared":
+		shared, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s must be true or false, got %q", key, value)
+		}
+		c.RateShared = shared
+	case "concurrency":
+		concurrency, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", key, value)
+		}
+		c.Concurrency = concurrency
+	default:
+		return unknownKeyError(key)
+	}
+
+	return nil
+}
+
+func (p *Profile) Get(key string) (string, bool, error) {
+	var value string
+	var set bool
+
+	switch key {
+	case "default_format":
+		if set = p.DefaultFormat != nil; set {
+			value = *p.DefaultFormat
+		}
+	case "default_limit":
+		if set = p.DefaultLimit != nil; set {
+			value = strconv.Itoa(*p.DefaultLimit)
+		}
+	case "cache_enabled":
+		if set = p.CacheEnabled != nil; set {
+			value = strconv.FormatBool(*p.CacheEnabled)
+		}
+	case "cache_ttl_seconds":
+		if set = p.CacheTTL != nil; set {
+			value = strconv.Itoa(*p.CacheTTL)
+		}
+	case "base_url":
+		if set = p.BaseURL != nil; set {
+			value = *p.BaseURL
+		}
+	case "country":
+		if set = p.Country != nil; set {
+			value = *p.Country
+		}
+	case "proxy":
+		if set = p.Proxy != nil; set {
+			value = *p.Proxy
+		}
+	case "timeout_seconds":
+		if set = p.Timeout != nil; set {
+			value = strconv.Itoa(*p.Timeout)
+		}
+	case "ca_bundle":
+		if set = p.CABundle != nil; set {
+			value = *p.CABundle
+		}
+	case "user_agent":
+		if set = p.UserAgent != nil; set {
+			value = *p.UserAgent
+		}
+	case "max_retries":
+		if set = p.MaxRetries != nil; set {
+			value = strconv.Itoa(*p.MaxRetries)
+		}
+	case "rate_limit_requests":
+		if set = p.RateRequests != nil; set {
+			value = strconv.Itoa(*p.RateRequests)
+		}
+	case "rate_limit_period_seconds":
+		if set = p.RatePeriod != nil; set {
+			value = strconv.Itoa(*p.RatePeriod)
+		}
+	case "rate_limit_shared":
+		if set = p.RateShared != nil; set {
+			value = strconv.FormatBool(*p.RateShared)
+		}
+	case "concurrency":
+		if set = p.Concurrency != nil; set {
+			value = strconv.Itoa(*p.Concurrency)
+		}
+	default:
+		return "", false, unknownKeyError(key)
+	}
+
+	return value, set, nil
+}
+
+func (p *Profile) Set(key, value string) error {
+	var parsed Config
+	if err := parsed.Set(key, value); err != nil {
+		return err
+	}
+
+	switch key {
+	case "default_format":
+		p.DefaultFormat = &parsed.DefaultFormat
+	case "default_limit":
+		p.DefaultLimit = &parsed.DefaultLimit
+	case "cache_enabled":
+		p.CacheEnabled = &parsed.CacheEnabled
+	case "cache_ttl_seconds":
+		p.CacheTTL = &parsed.CacheTTL
+	case "base_url":
+		p.BaseURL = &parsed.BaseURL
+	case "country":
+		p.Country = &parsed.Country
+	case "proxy":
+		p.Proxy = &parsed.Proxy
+	case "timeout_seconds":
+		p.Timeout = &parsed.Timeout
+	case "ca_bundle":
+		p.CABundle = &parsed.CABundle
+	case "user_agent":
+		p.UserAgent = &parsed.UserAgent
+	case "max_retries":
+		p.MaxRetries = &parsed.MaxRetries
+	case "rate_limit_requests":
+		p.RateRequests = &parsed.RateRequests
+	case "rate_limit_period_seconds":
+		p.RatePeriod = &parsed.RatePeriod
+	case "rate_limit_shared":
+		p.RateShared = &parsed.RateShared
+	case "concurrency":
+		p.Concurrency = &parsed.Concurrency
+	}
+
+	return nil
+}
+
+// Unset removes the override for key so the top-level value applies.
+func (p *Profile) Unset(key string) error {
+	switch key {
+	case "default_format":
+		p.DefaultFormat = nil
+	case "default_limit":
+		p.DefaultLimit = nil
+	case "cache_enabled":
+		p.CacheEnabled = nil
+	case "cache_ttl_seconds":
+		p.CacheTTL = nil
+	case "base_url":
+		p.BaseURL = nil
+	case "country":
+		p.Country = nil
+	case "proxy":
+		p.Proxy = nil
+	case "timeout_seconds":
+		p.Timeout = nil
+	case "ca_bundle":
+		p.CABundle = nil
+	case "user_agent":
+		p.UserAgent = nil
+	case "max_retries":
+		p.MaxRetries = nil
+	case "rate_limit_requests":
+		p.RateRequests = nil
+	case "rate_limit_period_seconds":
+		p.RatePeriod = nil
+	case "rate_limit_shared":
+		p.RateShared = nil
+	case "concurrency":
+		p.Concurrency = nil
+	default:
+		return unknownKeyError(key)
+	}
+
+	return nil
+}
+
+// Unset restores key to its built-in default.
+func (c *Config) Unset(key string) error {
+	value, err := Default().Get(key)
+	if err != nil {
+		return err
+	}
+
+	return c.Set(key, value)
+}
+
+func (c *Config) Validate() error {
+	problems := c.problems()
+
+	for _, name := range c.ProfileNames() {
+		profiled := *c
+		profiled.ApplyProfile(name)
+		for _, problem := range profiled.problems() {
+			problems = append(problems, fmt.Sprintf("profile %q: %s", name, problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	return nil
+}
+
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Config) problems() []string {
+	var problems []string
+
+	for _, key := range keys {
+		if problem := c.problem(key); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems
+}
+
+// problem describes what is wrong with the value of key, or returns an
+// empty string when it is valid.
+func (c *Config) problem(key string) string {
+	switch key {
+	case "default_format":
+		if !isFormat(c.DefaultFormat) {
+			return fmt.Sprintf("default_format %q is not one of: %s", c.DefaultFormat, strings.Join(Formats, ", "))
+		}
+	case "default_limit":
+		if c.DefaultLimit < 0 {
+			return fmt.Sprintf("default_limit must not be negative, got %d", c.DefaultLimit)
+		}
+	case "cache_ttl_seconds":
+		if c.CacheTTL < 0 {
+			return fmt.Sprintf("cache_ttl_seconds must not be negative, got %d", c.CacheTTL)
+		}
+	case "base_url":
+		if c.BaseURL != "" && !isHTTPURL(c.BaseURL) {
+			return fmt.Sprintf("base_url %q must be an http or https URL", c.BaseURL)
+		}
+	case "country":
+		if c.Country != "" && !isCountryCode(c.Country) {
+			return fmt.Sprintf("country %q must be a two-letter ISO 3166 code", c.Country)
+		}
+	case "proxy":
+		if c.Proxy != "" && !isProxyURL(c.Proxy) {
+			return fmt.Sprintf("proxy %q must be an http, https or socks5 URL", c.Proxy)
+		}
+	case "timeout_seconds":
+		if c.Timeout < 1 {
+			return fmt.Sprintf("timeout_seconds must be at least 1, got %d", c.Timeout)
+		}
+	case "max_retries":
+		if c.MaxRetries < 0 {
+			return fmt.Sprintf("max_retries must not be negative, got %d", c.MaxRetries)
+		}
+	case "rate_limit_requests":
+		if c.RateRequests <= 0 {
+			return fmt.Sprintf("rate_limit_requests must be positive, got %d", c.RateRequests)
+		}
+	case "rate_limit_period_seconds":
+		if c.RatePeriod <= 0 {
+			return fmt.Sprintf("rate_limit_period_seconds must be positive, got %d", c.RatePeriod)
+		}
+	case "concurrency":
+		if c.Concurrency <= 0 {
+			return fmt.Sprintf("concurrency must be positive, got %d", c.Concurrency)
+		}
+	case "ca_bundle":
+		if c.CABundle != "" {
+			if _, err := os.Stat(c.CABundle); err != nil {
+				return fmt.Sprintf("ca_bundle %q is not readable: %v", c.CABundle, err)
+			}
+		}
+	}
+
+	return ""
+}
+
+// Check strictly parses the config file, rejecting unknown keys, and
+// validates its values. A missing file is valid.
+func Check() error {
+	data, err := os.ReadFile(Path())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	config := defaultConfig
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", Path(), err)
+	}
+
+	return config.Validate()
+}
+
+func isFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func isProxyURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https" || u.Scheme == "socks5") && u.Host != ""
+}
+
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("unknown config key %q, valid keys: %s", key, strings.Join(keys, ", "))
+}
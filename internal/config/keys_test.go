@@ -0,0 +1,146 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		problem string
+	}{
+		{"defaults", func(c *Config) {}, ""},
+		{"fetch all", func(c *Config) { c.DefaultLimit = 0 }, ""},
+		{"unknown format", func(c *Config) { c.DefaultFormat = "xml" }, "default_format"},
+		{"negative limit", func(c *Config) { c.DefaultLimit = -1 }, "default_limit"},
+		{"negative ttl", func(c *Config) { c.CacheTTL = -5 }, "cache_ttl_seconds"},
+		{"base url scheme", func(c *Config) { c.BaseURL = "ftp://example.com" }, "base_url"},
+		{"base url host", func(c *Config) { c.BaseURL = "https://" }, "base_url"},
+		{"lowercase country", func(c *Config) { c.Country = "fr" }, "country"},
+		{"long country", func(c *Config) { c.Country = "FRA" }, "country"},
+		{"socks proxy", func(c *Config) { c.Proxy = "socks5://localhost:1080" }, ""},
+		{"proxy scheme", func(c *Config) { c.Proxy = "ftp://localhost" }, "proxy"},
+		{"negative timeout", func(c *Config) { c.Timeout = -1 }, "timeout_seconds"},
+		{"zero timeout", func(c *Config) { c.Timeout = 0 }, "timeout_seconds"},
+		{"negative retries", func(c *Config) { c.MaxRetries = -1 }, "max_retries"},
+		{"zero rate requests", func(c *Config) { c.RateRequests = 0 }, "rate_limit_requests"},
+		{"zero rate period", func(c *Config) { c.RatePeriod = 0 }, "rate_limit_period_seconds"},
+		{"zero concurrency", func(c *Config) { c.Concurrency = 0 }, "concurrency"},
+		{"missing ca bundle", func(c *Config) { c.CABundle = filepath.Join(t.TempDir(), "missing.pem") }, "ca_bundle"},
+		{"invalid profile", func(c *Config) {
+			limit := -3
+			c.Profiles = map[string]Profile{"ci": {DefaultLimit: &limit}}
+		}, `profile "ci": default_limit`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.change(cfg)
+
+			err := cfg.Validate()
+			if tt.problem == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("Validate() = %v, want a problem with %s", err, tt.problem)
+			}
+		})
+	}
+}
+
+func TestSetGetRoundTrip(t *testing.T) {
+	values := map[string]string{
+		"default_format":            "json",
+		"default_limit":             "0",
+		"cache_enabled":             "false",
+		"cache_ttl_seconds":         "60",
+		"base_url":                  "http://localhost:8080",
+		"country":                   "FR",
+		"proxy":                     "http://proxy:3128",
+		"timeout_seconds":           "30",
+		"ca_bundle":                 "/etc/ssl/ca.pem",
+		"user_agent":                "reports/1.0",
+		"max_retries":               "5",
+		"rate_limit_requests":       "25",
+		"rate_limit_period_seconds": "10",
+		"rate_limit_shared":         "true",
+		"concurrency":               "8",
+	}
+
+	for _, key := range Keys() {
+		value, ok := values[key]
+		if !ok {
+			t.Errorf("no test value for key %s", key)
+			continue
+		}
+
+		cfg := Default()
+		if err := cfg.Set(key, value); err != nil {
+			t.Fatalf("Set(%s, %q): %v", key, value, err)
+		}
+		if got, err := cfg.Get(key); err != nil || got != value {
+			t.Errorf("Get(%s) = %q, %v; want %q", key, got, err, value)
+		}
+
+		var profile Profile
+		if err := profile.Set(key, value); err != nil {
+			t.Fatalf("Profile.Set(%s, %q): %v", key, value, err)
+		}
+		if got, set, err := profile.Get(key); err != nil || !set || got != value {
+			t.Errorf("Profile.Get(%s) = %q, %t, %v; want %q", key, got, set, err, value)
+		}
+		if err := profile.Unset(key); err != nil {
+			t.Fatal(err)
+		}
+		if _, set, _ := profile.Get(key); set {
+			t.Errorf("Profile.Get(%s) after Unset is still set", key)
+		}
+	}
+}
+
+func TestSetRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+	}{
+		{"default_limit", "ten"},
+		{"cache_enabled", "maybe"},
+		{"timeout_seconds", "1.5"},
+		{"rate_limit_shared", "yes please"},
+		{"concurrency", "many"},
+		{"no_such_key", "1"},
+	}
+
+	for _, tt := range tests {
+		if err := Default().Set(tt.key, tt.value); err == nil {
+			t.Errorf("Set(%s, %q) succeeded, want an error", tt.key, tt.value)
+		}
+	}
+}
+
+func TestUnsetRestoresDefault(t *testing.T) {
+	cfg := Default()
+	cfg.DefaultLimit = 7
+
+	if err := cfg.Unset("default_limit"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultLimit != defaultConfig.DefaultLimit {
+		t.Errorf("DefaultLimit = %d, want %d", cfg.DefaultLimit, defaultConfig.DefaultLimit)
+	}
+}
+
+func TestCheckRejectsUnknownKeys(t *testing.T) {
+	writeConfig(t, `{"default_format": "json", "defualt_limit": 10}`)
+
+	if err := Check(); err == nil || !strings.Contains(err.Error(), "defualt_limit") {
+		t.Errorf("Check() = %v, want an unknown field error", err)
+	}
+}
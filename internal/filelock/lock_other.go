@@ -0,0 +1,36 @@
+//go:build !unix
+
+package filelock
+
+import (
+	"os"
+	"time"
+)
+
+const staleLockAge = 10 * time.Second
+
+// Lock emulates an exclusive lock with a sibling lock file created
+// with O_EXCL, breaking locks left behind by crashed processes.
+func Lock(file *os.File) (func(), error) {
+	lockPath := file.Name() + ".lock"
+
+	for {
+		lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			lock.Close()
+			return func() {
+				os.Remove(lockPath)
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			os.Remove(lockPath)
+			continue
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
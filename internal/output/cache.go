@@ -0,0 +1,133 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/felipemarinho97/deezer-cli/internal/cache"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatCacheStats(stats *cache.Stats) {
+	switch f.format {
+	case "json":
+		f.outputJSON(stats)
+	case "yaml":
+		f.outputYAML(stats)
+	default:
+		f.outputCacheStatsDetail(stats)
+	}
+}
+
+func (f *Formatter) FormatCacheEntries(entries []cache.Entry) {
+	if len(entries) == 0 {
+		fmt.Println("No cache entries found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(entries)
+	case "yaml":
+		f.outputYAML(entries)
+	case "ids":
+		for _, entry := range entries {
+			fmt.Println(entry.Key)
+		}
+	default:
+		f.outputCacheEntriesTable(entries)
+	}
+}
+
+func (f *Formatter) FormatCacheEntry(entry *cache.Entry) {
+	switch f.format {
+	case "yaml":
+		f.outputYAML(entry)
+	case "ids":
+		fmt.Println(entry.Key)
+	default:
+		f.outputJSON(entry)
+	}
+}
+
+func (f *Formatter) outputCacheStatsDetail(stats *cache.Stats) {
+	bold := color.New(color.Bold)
+	blue := color.New(color.FgBlue)
+
+	bold.Println("Cache Statistics")
+	fmt.Println(strings.Repeat("─", 50))
+
+	blue.Print("Directory: ")
+	fmt.Println(stats.Dir)
+
+	blue.Print("Entries: ")
+	fmt.Printf("%d (%d expired)\n", stats.Entries, stats.Expired)
+
+	blue.Print("Size: ")
+	fmt.Println(formatBytes(stats.Size))
+
+	blue.Print("Hits / Misses: ")
+	fmt.Printf("%d / %d (%.1f%% hit ratio)\n", stats.Hits, stats.Misses, stats.HitRatio()*100)
+
+	blue.Print("Oldest: ")
+	fmt.Println(formatTime(stats.Oldest))
+
+	blue.Print("Newest: ")
+	fmt.Println(formatTime(stats.Newest))
+}
+
+func (f *Formatter) outputCacheEntriesTable(entries []cache.Entry) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Key", "Size", "Created", "Expires", "Expired"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetAutoWrapText(false)
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+	)
+
+	for _, entry := range entries {
+		expired := "No"
+		if entry.Expired() {
+			expired = "Yes"
+		}
+
+		table.Append([]string{
+			truncate(entry.Key, 60),
+			formatBytes(entry.Size),
+			formatTime(entry.CreatedAt),
+			formatTime(entry.ExpiresAt),
+			expired,
+		})
+	}
+
+	table.Render()
+}
+
+func formatBytes(n int64) string {
+	if n < 1024 {
+		return strconv.FormatInt(n, 10) + " B"
+	} else if n < 1024*1024 {
+		return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+	} else {
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1024*1024))
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,50 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/config"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatConfig(cfg *config.Config) {
+	switch f.format {
+	case "json":
+		f.outputJSON(cfg)
+	case "yaml":
+		f.outputYAML(cfg)
+	case "ids":
+		for _, key := range config.Keys() {
+			fmt.Println(key)
+		}
+	default:
+		f.outputConfigTable(cfg)
+	}
+}
+
+func (f *Formatter) outputConfigTable(cfg *config.Config) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Key", "Value"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+	)
+
+	for _, key := range config.Keys() {
+		value, _ := cfg.Get(key)
+		table.Append([]string{key, value})
+	}
+	if len(cfg.Profiles) > 0 {
+		table.Append([]string{"profiles", strings.Join(cfg.ProfileNames(), ", ")})
+	}
+
+	table.Render()
+}
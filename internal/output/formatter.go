@@ -19,6 +19,7 @@ type Formatter struct {
 	format   string
 	idsOnly  bool
 	fields   []string
+	country  string
 }
 
 func NewFormatter(format string, idsOnly bool, fields []string) *Formatter {
@@ -32,6 +33,12 @@ func NewFormatter(format string, idsOnly bool, fields []string) *Formatter {
 	}
 }
 
+// SetCountry sets the ISO 3166-1 country code used to report track
+// availability in detail views.
+func (f *Formatter) SetCountry(country string) {
+	f.country = strings.ToUpper(country)
+}
+
 func (f *Formatter) FormatTracks(tracks []api.Track) {
 	if len(tracks) == 0 {
 		fmt.Println("No tracks found")
@@ -159,6 +166,21 @@ func (f *Formatter) outputTracksTable(tracks []api.Track) {
 	if f.shouldIncludeField("rank", "all") {
 		headers = append(headers, "Rank")
 	}
+	if f.shouldIncludeField("isrc") {
+		headers = append(headers, "ISRC")
+	}
+	if f.shouldIncludeField("position") {
+		headers = append(headers, "Disc/Pos")
+	}
+	if f.shouldIncludeField("release") {
+		headers = append(headers, "Release")
+	}
+	if f.shouldIncludeField("contributors") {
+		headers = append(headers, "Contributors")
+	}
+	if f.shouldIncludeField("readable") {
+		headers = append(headers, "Readable")
+	}
 	
 	table.SetHeader(headers)
 	table.SetBorder(true)
@@ -185,6 +207,21 @@ func (f *Formatter) outputTracksTable(tracks []api.Track) {
 		if f.shouldIncludeField("rank", "all") {
 			row = append(row, strconv.Itoa(track.Rank))
 		}
+		if f.shouldIncludeField("isrc") {
+			row = append(row, track.ISRC)
+		}
+		if f.shouldIncludeField("position") {
+			row = append(row, fmt.Sprintf("%d/%d", track.DiskNumber, track.TrackPosition))
+		}
+		if f.shouldIncludeField("release") {
+			row = append(row, track.ReleaseDate)
+		}
+		if f.shouldIncludeField("contributors") {
+			row = append(row, truncate(strings.Join(track.GetContributorNames(), ", "), 40))
+		}
+		if f.shouldIncludeField("readable") {
+			row = append(row, strconv.FormatBool(track.Readable))
+		}
 		
 		table.Append(row)
 	}
@@ -194,30 +231,69 @@ func (f *Formatter) outputTracksTable(tracks []api.Track) {
 
 func (f *Formatter) outputAlbumsTable(albums []api.Album) {
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Title", "Artist", "Tracks", "Release", "Link"})
+
+	headers := []string{"ID", "Title", "Artist", "Tracks", "Release", "Link"}
+	if f.shouldIncludeField("upc") {
+		headers = append(headers, "UPC")
+	}
+	if f.shouldIncludeField("label") {
+		headers = append(headers, "Label")
+	}
+	if f.shouldIncludeField("genres") {
+		headers = append(headers, "Genres")
+	}
+	if f.shouldIncludeField("duration") {
+		headers = append(headers, "Duration")
+	}
+	if f.shouldIncludeField("fans") {
+		headers = append(headers, "Fans")
+	}
+	if f.shouldIncludeField("available") {
+		headers = append(headers, "Available")
+	}
+
+	table.SetHeader(headers)
 	table.SetBorder(true)
 	table.SetRowLine(false)
 	table.SetCenterSeparator("│")
 	table.SetColumnSeparator("│")
 	table.SetRowSeparator("─")
-	table.SetHeaderColor(
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-		tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor},
-	)
+	headerColors := make([]tablewriter.Colors, len(headers))
+	for i := range headerColors {
+		headerColors[i] = tablewriter.Colors{tablewriter.Bold, tablewriter.FgGreenColor}
+	}
+	table.SetHeaderColor(headerColors...)
 
 	for _, album := range albums {
-		table.Append([]string{
+		row := []string{
 			strconv.FormatInt(album.ID, 10),
 			truncate(album.Title, 30),
 			truncate(album.Artist.Name, 20),
 			strconv.Itoa(album.NbTracks),
 			album.ReleaseDate,
 			album.Link,
-		})
+		}
+
+		if f.shouldIncludeField("upc") {
+			row = append(row, album.UPC)
+		}
+		if f.shouldIncludeField("label") {
+			row = append(row, truncate(album.Label, 25))
+		}
+		if f.shouldIncludeField("genres") {
+			row = append(row, truncate(strings.Join(album.GetGenreNames(), ", "), 25))
+		}
+		if f.shouldIncludeField("duration") {
+			row = append(row, album.GetDurationFormatted())
+		}
+		if f.shouldIncludeField("fans") {
+			row = append(row, formatNumber(album.Fans))
+		}
+		if f.shouldIncludeField("available") {
+			row = append(row, strconv.FormatBool(album.Available))
+		}
+
+		table.Append(row)
 	}
 	
 	table.Render()
@@ -380,7 +456,8 @@ func (f *Formatter) outputTracksCSV(tracks []api.Track) {
 	writer := csv.NewWriter(os.Stdout)
 	defer writer.Flush()
 
-	writer.Write([]string{"ID", "Title", "Artist", "Album", "Duration", "Link", "Rank"})
+	writer.Write([]string{"ID", "Title", "Artist", "Album", "Duration", "Link", "Rank", "ISRC",
+		"DiskNumber", "TrackPosition", "ReleaseDate", "Contributors", "AvailableCountries", "Readable", "MD5Image"})
 	
 	for _, track := range tracks {
 		writer.Write([]string{
@@ -391,6 +468,14 @@ func (f *Formatter) outputTracksCSV(tracks []api.Track) {
 			strconv.Itoa(track.Duration),
 			track.Link,
 			strconv.Itoa(track.Rank),
+			track.ISRC,
+			strconv.Itoa(track.DiskNumber),
+			strconv.Itoa(track.TrackPosition),
+			track.ReleaseDate,
+			strings.Join(track.GetContributorNames(), "; "),
+			strings.Join(track.AvailableCountries, " "),
+			strconv.FormatBool(track.Readable),
+			track.MD5Image,
 		})
 	}
 }
@@ -399,17 +484,32 @@ func (f *Formatter) outputAlbumsCSV(albums []api.Album) {
 	writer := csv.NewWriter(os.Stdout)
 	defer writer.Flush()
 
-	writer.Write([]string{"ID", "Title", "Artist", "Tracks", "ReleaseDate", "Link"})
+	writer.Write(albumCSVHeader)
 	
 	for _, album := range albums {
-		writer.Write([]string{
-			strconv.FormatInt(album.ID, 10),
-			album.Title,
-			album.Artist.Name,
-			strconv.Itoa(album.NbTracks),
-			album.ReleaseDate,
-			album.Link,
-		})
+		writer.Write(albumCSVRecord(album))
+	}
+}
+
+var albumCSVHeader = []string{"ID", "Title", "Artist", "Tracks", "ReleaseDate", "Link", "UPC",
+	"Label", "Genres", "Contributors", "Duration", "Fans", "Available", "MD5Image"}
+
+func albumCSVRecord(album api.Album) []string {
+	return []string{
+		strconv.FormatInt(album.ID, 10),
+		album.Title,
+		album.Artist.Name,
+		strconv.Itoa(album.NbTracks),
+		album.ReleaseDate,
+		album.Link,
+		album.UPC,
+		album.Label,
+		strings.Join(album.GetGenreNames(), "; "),
+		strings.Join(album.GetContributorNames(), "; "),
+		strconv.Itoa(album.Duration),
+		strconv.Itoa(album.Fans),
+		strconv.FormatBool(album.Available),
+		album.MD5Image,
 	}
 }
 
@@ -583,6 +683,8 @@ func (f *Formatter) FormatAlbum(album *api.Album) {
 	switch f.format {
 	case "json":
 		f.outputJSON(album)
+	case "csv":
+		f.outputAlbumTracklistCSV(album)
 	case "yaml":
 		f.outputYAML(album)
 	case "ids":
@@ -683,9 +785,33 @@ func (f *Formatter) outputTrackDetail(track *api.Track) {
 	cyan.Print("Album: ")
 	fmt.Printf("%s (ID: %d)\n", track.Album.Title, track.Album.ID)
 	
+	cyan.Print("ISRC: ")
+	fmt.Println(track.ISRC)
+	
+	cyan.Print("Position: ")
+	fmt.Printf("Disc %d, Track %d\n", track.DiskNumber, track.TrackPosition)
+	
+	cyan.Print("Release Date: ")
+	fmt.Println(track.ReleaseDate)
+	
 	cyan.Print("Duration: ")
 	fmt.Println(track.GetDurationFormatted())
 	
+	if len(track.Contributors) > 0 {
+		cyan.Print("Contributors: ")
+		fmt.Println(strings.Join(track.GetContributorNames(), ", "))
+	}
+	
+	cyan.Print("Readable: ")
+	fmt.Println(track.Readable)
+	
+	cyan.Print("Available Countries: ")
+	if f.country != "" {
+		fmt.Printf("%d (available in %s: %t)\n", len(track.AvailableCountries), f.country, track.IsAvailableIn(f.country))
+	} else {
+		fmt.Println(len(track.AvailableCountries))
+	}
+	
 	cyan.Print("Rank: ")
 	fmt.Println(track.Rank)
 	
@@ -715,9 +841,32 @@ func (f *Formatter) outputAlbumDetail(album *api.Album) {
 	green.Print("Artist: ")
 	fmt.Printf("%s (ID: %d)\n", album.Artist.Name, album.Artist.ID)
 	
+	green.Print("UPC: ")
+	fmt.Println(album.UPC)
+	
+	green.Print("Label: ")
+	fmt.Println(album.Label)
+	
+	green.Print("Genres: ")
+	fmt.Println(formatAlbumGenres(album))
+	
+	if len(album.Contributors) > 0 {
+		green.Print("Contributors: ")
+		fmt.Println(strings.Join(album.GetContributorNames(), ", "))
+	}
+	
 	green.Print("Tracks: ")
 	fmt.Println(album.NbTracks)
 	
+	green.Print("Duration: ")
+	fmt.Println(album.GetDurationFormatted())
+	
+	green.Print("Fans: ")
+	fmt.Println(formatNumber(album.Fans))
+	
+	green.Print("Available: ")
+	fmt.Println(album.Available)
+	
 	green.Print("Release Date: ")
 	fmt.Println(album.ReleaseDate)
 	
@@ -735,6 +884,88 @@ func (f *Formatter) outputAlbumDetail(album *api.Album) {
 	
 	green.Print("Tracklist: ")
 	fmt.Println(album.Tracklist)
+
+	if album.Tracks != nil && len(album.Tracks.Data) > 0 {
+		fmt.Println()
+		f.outputAlbumTracklist(album)
+	}
+}
+
+func (f *Formatter) outputAlbumTracklist(album *api.Album) {
+	bold := color.New(color.Bold)
+	red := color.New(color.FgRed)
+
+	bold.Println("Tracklist")
+	fmt.Println(strings.Repeat("─", 50))
+
+	multiDisc := false
+	for _, track := range album.Tracks.Data {
+		if track.DiskNumber > 1 {
+			multiDisc = true
+			break
+		}
+	}
+
+	for i, track := range album.Tracks.Data {
+		disc, position := trackPosition(track, i)
+
+		number := fmt.Sprintf("%2d.", position)
+		if multiDisc {
+			number = fmt.Sprintf("%d-%02d", disc, position)
+		}
+
+		fmt.Printf("%s %-45s %7s", number, truncate(track.Title, 45), track.GetDurationFormatted())
+		if track.ExplicitLyrics {
+			red.Print(" [E]")
+		}
+		fmt.Println()
+	}
+}
+
+// outputAlbumTracklistCSV writes one row per track, each starting with the
+// same album columns as the album list CSV. An album without tracks still
+// gets a single row with empty track columns.
+func (f *Formatter) outputAlbumTracklistCSV(album *api.Album) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	header := append(append([]string(nil), albumCSVHeader...),
+		"Disc", "Position", "TrackID", "TrackTitle", "TrackArtist", "TrackDuration", "Explicit", "ISRC")
+	writer.Write(header)
+
+	albumRecord := albumCSVRecord(*album)
+	if album.Tracks == nil || len(album.Tracks.Data) == 0 {
+		writer.Write(append(albumRecord, make([]string, len(header)-len(albumRecord))...))
+		return
+	}
+
+	for i, track := range album.Tracks.Data {
+		disc, position := trackPosition(track, i)
+		record := append(append([]string(nil), albumRecord...),
+			strconv.Itoa(disc),
+			strconv.Itoa(position),
+			strconv.FormatInt(track.ID, 10),
+			track.Title,
+			track.Artist.Name,
+			strconv.Itoa(track.Duration),
+			strconv.FormatBool(track.ExplicitLyrics),
+			track.ISRC,
+		)
+		writer.Write(record)
+	}
+}
+
+// trackPosition returns the disc and position of the i-th track of an
+// album, numbering tracks in order when the API did not include them.
+func trackPosition(track api.Track, i int) (int, int) {
+	disc, position := track.DiskNumber, track.TrackPosition
+	if disc == 0 {
+		disc = 1
+	}
+	if position == 0 {
+		position = i + 1
+	}
+	return disc, position
 }
 
 func (f *Formatter) outputArtistDetail(artist *api.Artist) {
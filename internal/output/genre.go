@@ -0,0 +1,123 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatGenres(genres []api.Genre) {
+	if len(genres) == 0 {
+		fmt.Println("No genres found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(genres)
+	case "csv":
+		f.outputGenresCSV(genres)
+	case "yaml":
+		f.outputYAML(genres)
+	case "ids":
+		for _, genre := range genres {
+			fmt.Println(genre.ID)
+		}
+	default:
+		f.outputGenresTable(genres)
+	}
+}
+
+func (f *Formatter) FormatGenre(genre *api.Genre) {
+	if genre == nil {
+		fmt.Println("Genre not found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(genre)
+	case "yaml":
+		f.outputYAML(genre)
+	case "ids":
+		fmt.Println(genre.ID)
+	default:
+		f.outputGenreDetail(genre)
+	}
+}
+
+func (f *Formatter) outputGenresTable(genres []api.Genre) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Picture"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgMagentaColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgMagentaColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgMagentaColor},
+	)
+
+	for _, genre := range genres {
+		table.Append([]string{
+			strconv.FormatInt(genre.ID, 10),
+			truncate(genre.Name, 30),
+			genre.Picture,
+		})
+	}
+
+	table.Render()
+}
+
+func (f *Formatter) outputGenresCSV(genres []api.Genre) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	writer.Write([]string{"ID", "Name", "Picture"})
+
+	for _, genre := range genres {
+		writer.Write([]string{
+			strconv.FormatInt(genre.ID, 10),
+			genre.Name,
+			genre.Picture,
+		})
+	}
+}
+
+func (f *Formatter) outputGenreDetail(genre *api.Genre) {
+	bold := color.New(color.Bold)
+	magenta := color.New(color.FgMagenta)
+
+	bold.Println("Genre Details")
+	fmt.Println(strings.Repeat("─", 50))
+
+	magenta.Print("ID: ")
+	fmt.Println(genre.ID)
+
+	magenta.Print("Name: ")
+	fmt.Println(genre.Name)
+
+	magenta.Print("Picture: ")
+	fmt.Println(genre.PictureBig)
+}
+
+// formatAlbumGenres names the genres of an album along with its primary
+// genre ID, e.g. "Dance (ID: 113)".
+func formatAlbumGenres(album *api.Album) string {
+	names := strings.Join(album.GetGenreNames(), ", ")
+	if album.GenreID <= 0 {
+		return names
+	}
+	if names == "" {
+		return fmt.Sprintf("ID: %d", album.GenreID)
+	}
+	return fmt.Sprintf("%s (ID: %d)", names, album.GenreID)
+}
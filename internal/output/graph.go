@@ -0,0 +1,136 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+)
+
+// GraphFormats lists the formats FormatArtistGraph can write.
+var GraphFormats = []string{"dot", "graphml", "json"}
+
+// FormatArtistGraph writes a related-artist graph as Graphviz DOT, GraphML
+// or a nodes/edges JSON document.
+func (f *Formatter) FormatArtistGraph(graph *api.ArtistGraph, format string) error {
+	switch format {
+	case "dot":
+		f.outputGraphDOT(graph)
+	case "graphml":
+		return f.outputGraphML(graph)
+	case "json":
+		f.outputJSON(graph)
+	default:
+		return fmt.Errorf("unknown graph format %q, use one of: %s", format, strings.Join(GraphFormats, ", "))
+	}
+	return nil
+}
+
+func (f *Formatter) outputGraphDOT(graph *api.ArtistGraph) {
+	fmt.Println("digraph related_artists {")
+	fmt.Println("  node [shape=ellipse];")
+
+	for _, node := range graph.Nodes {
+		fmt.Printf("  \"%d\" [label=%s, nb_fan=%d, nb_album=%d, depth=%d];\n",
+			node.ID, dotQuote(node.Name), node.NbFan, node.NbAlbum, node.Depth)
+	}
+
+	for _, edge := range graph.Edges {
+		fmt.Printf("  \"%d\" -> \"%d\" [hop=%d, weight=%d];\n", edge.Source, edge.Target, edge.Hop, edge.Weight)
+	}
+
+	fmt.Println("}")
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (f *Formatter) outputGraphML(graph *api.ArtistGraph) error {
+	doc := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "nb_fan", For: "node", AttrName: "nb_fan", AttrType: "int"},
+			{ID: "nb_album", For: "node", AttrName: "nb_album", AttrType: "int"},
+			{ID: "depth", For: "node", AttrName: "depth", AttrType: "int"},
+			{ID: "link", For: "node", AttrName: "link", AttrType: "string"},
+			{ID: "hop", For: "edge", AttrName: "hop", AttrType: "int"},
+			{ID: "weight", For: "edge", AttrName: "weight", AttrType: "int"},
+		},
+		Graph: graphMLGraph{ID: "related_artists", EdgeDefault: "directed"},
+	}
+
+	for _, node := range graph.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: strconv.FormatInt(node.ID, 10),
+			Data: []graphMLData{
+				{Key: "label", Value: node.Name},
+				{Key: "nb_fan", Value: strconv.Itoa(node.NbFan)},
+				{Key: "nb_album", Value: strconv.Itoa(node.NbAlbum)},
+				{Key: "depth", Value: strconv.Itoa(node.Depth)},
+				{Key: "link", Value: node.Link},
+			},
+		})
+	}
+
+	for _, edge := range graph.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: strconv.FormatInt(edge.Source, 10),
+			Target: strconv.FormatInt(edge.Target, 10),
+			Data: []graphMLData{
+				{Key: "hop", Value: strconv.Itoa(edge.Hop)},
+				{Key: "weight", Value: strconv.Itoa(edge.Weight)},
+			},
+		})
+	}
+
+	fmt.Print(xml.Header)
+	encoder := xml.NewEncoder(os.Stdout)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write GraphML: %w", err)
+	}
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+)
+
+// captureStdout returns what fn writes to os.Stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+
+	fn()
+	w.Close()
+	return string(<-done)
+}
+
+func testGraph() *api.ArtistGraph {
+	return &api.ArtistGraph{
+		Nodes: []api.RelatedArtist{
+			{Artist: api.Artist{ID: 27, Name: "Daft Punk", NbFan: 100, NbAlbum: 30}},
+			{Artist: api.Artist{ID: 1, Name: `Justice "†"`, NbFan: 50, NbAlbum: 10}, Depth: 1, ParentID: 27},
+		},
+		Edges: []api.ArtistEdge{{Source: 27, Target: 1, Hop: 1, Weight: 2}},
+	}
+}
+
+func TestFormatArtistGraphDOT(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := NewFormatter("table", false, nil).FormatArtistGraph(testGraph(), "dot"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	for _, want := range []string{
+		"digraph related_artists {",
+		`"27" [label="Daft Punk", nb_fan=100, nb_album=30, depth=0];`,
+		`"1" [label="Justice \"†\"", nb_fan=50, nb_album=10, depth=1];`,
+		`"27" -> "1" [hop=1, weight=2];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("DOT output is missing %s:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatArtistGraphML(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := NewFormatter("table", false, nil).FormatArtistGraph(testGraph(), "graphml"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var doc graphML
+	if err := xml.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("invalid GraphML: %v\n%s", err, out)
+	}
+
+	for _, key := range doc.Keys {
+		if key.ID == "weight" && key.AttrType != "int" {
+			t.Errorf("weight attr.type = %q, want int", key.AttrType)
+		}
+	}
+	if len(doc.Graph.Nodes) != 2 || doc.Graph.Nodes[1].Data[0].Value != `Justice "†"` {
+		t.Errorf("nodes = %+v", doc.Graph.Nodes)
+	}
+	if len(doc.Graph.Edges) != 1 {
+		t.Fatalf("edges = %+v, want one", doc.Graph.Edges)
+	}
+	edge := doc.Graph.Edges[0]
+	if edge.Source != "27" || edge.Target != "1" {
+		t.Errorf("edge = %s -> %s, want 27 -> 1", edge.Source, edge.Target)
+	}
+}
+
+func TestFormatArtistGraphJSON(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := NewFormatter("table", false, nil).FormatArtistGraph(testGraph(), "json"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var graph api.ArtistGraph
+	if err := json.Unmarshal([]byte(out), &graph); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(graph.Nodes) != 2 || graph.Nodes[1].ParentID != 27 || len(graph.Edges) != 1 {
+		t.Errorf("graph = %+v", graph)
+	}
+}
+
+func TestFormatArtistGraphRejectsUnknownFormats(t *testing.T) {
+	if err := NewFormatter("table", false, nil).FormatArtistGraph(testGraph(), "gexf"); err == nil {
+		t.Error("unknown format accepted")
+	}
+}
@@ -0,0 +1,122 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatRadios(radios []api.Radio) {
+	if len(radios) == 0 {
+		fmt.Println("No radios found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(radios)
+	case "csv":
+		f.outputRadiosCSV(radios)
+	case "yaml":
+		f.outputYAML(radios)
+	case "ids":
+		for _, radio := range radios {
+			fmt.Println(radio.ID)
+		}
+	default:
+		f.outputRadiosTable(radios)
+	}
+}
+
+func (f *Formatter) FormatRadio(radio *api.Radio) {
+	if radio == nil {
+		fmt.Println("Radio not found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(radio)
+	case "yaml":
+		f.outputYAML(radio)
+	case "ids":
+		fmt.Println(radio.ID)
+	default:
+		f.outputRadioDetail(radio)
+	}
+}
+
+func (f *Formatter) outputRadiosTable(radios []api.Radio) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "Description", "Tracklist"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgRedColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgRedColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgRedColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgRedColor},
+	)
+
+	for _, radio := range radios {
+		table.Append([]string{
+			strconv.FormatInt(radio.ID, 10),
+			truncate(radio.Title, 30),
+			truncate(radio.Description, 40),
+			radio.Tracklist,
+		})
+	}
+
+	table.Render()
+}
+
+func (f *Formatter) outputRadiosCSV(radios []api.Radio) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	writer.Write([]string{"ID", "Title", "Description", "Tracklist"})
+
+	for _, radio := range radios {
+		writer.Write([]string{
+			strconv.FormatInt(radio.ID, 10),
+			radio.Title,
+			radio.Description,
+			radio.Tracklist,
+		})
+	}
+}
+
+func (f *Formatter) outputRadioDetail(radio *api.Radio) {
+	bold := color.New(color.Bold)
+	red := color.New(color.FgRed)
+
+	bold.Println("Radio Details")
+	fmt.Println(strings.Repeat("─", 50))
+
+	red.Print("ID: ")
+	fmt.Println(radio.ID)
+
+	red.Print("Title: ")
+	fmt.Println(radio.Title)
+
+	red.Print("Description: ")
+	fmt.Println(radio.Description)
+
+	red.Print("Picture: ")
+	fmt.Println(radio.PictureBig)
+
+	red.Print("Link: ")
+	fmt.Println(radio.Share)
+
+	red.Print("Tracklist: ")
+	fmt.Println(radio.Tracklist)
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatRelatedArtists(artists []api.RelatedArtist) {
+	if len(artists) == 0 {
+		fmt.Println("No related artists found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(artists)
+	case "csv":
+		f.outputRelatedArtistsCSV(artists)
+	case "yaml":
+		f.outputYAML(artists)
+	case "ids":
+		for _, artist := range artists {
+			fmt.Println(artist.ID)
+		}
+	default:
+		f.outputRelatedArtistsTable(artists)
+	}
+}
+
+func (f *Formatter) outputRelatedArtistsTable(artists []api.RelatedArtist) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Depth", "Via", "Albums", "Fans", "Link"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	headerColors := make([]tablewriter.Colors, 7)
+	for i := range headerColors {
+		headerColors[i] = tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	}
+	table.SetHeaderColor(headerColors...)
+
+	for _, artist := range artists {
+		table.Append([]string{
+			strconv.FormatInt(artist.ID, 10),
+			truncate(artist.Name, 30),
+			strconv.Itoa(artist.Depth),
+			strconv.FormatInt(artist.ParentID, 10),
+			strconv.Itoa(artist.NbAlbum),
+			formatNumber(artist.NbFan),
+			artist.Link,
+		})
+	}
+
+	table.Render()
+}
+
+func (f *Formatter) outputRelatedArtistsCSV(artists []api.RelatedArtist) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	writer.Write([]string{"ID", "Name", "Depth", "ParentID", "Albums", "Fans", "Link"})
+
+	for _, artist := range artists {
+		writer.Write([]string{
+			strconv.FormatInt(artist.ID, 10),
+			artist.Name,
+			strconv.Itoa(artist.Depth),
+			strconv.FormatInt(artist.ParentID, 10),
+			strconv.Itoa(artist.NbAlbum),
+			strconv.Itoa(artist.NbFan),
+			artist.Link,
+		})
+	}
+}
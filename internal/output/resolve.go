@@ -0,0 +1,73 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatResources(resources []api.Resource) {
+	if len(resources) == 0 {
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(resources)
+	case "csv":
+		f.outputResourcesCSV(resources)
+	case "yaml":
+		f.outputYAML(resources)
+	case "ids":
+		for _, resource := range resources {
+			fmt.Println(resource.ID)
+		}
+	default:
+		f.outputResourcesTable(resources)
+	}
+}
+
+func (f *Formatter) outputResourcesTable(resources []api.Resource) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Type", "ID", "URL"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetAutoWrapText(false)
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgCyanColor},
+	)
+
+	for _, resource := range resources {
+		table.Append([]string{
+			resource.Type,
+			strconv.FormatInt(resource.ID, 10),
+			resource.URL,
+		})
+	}
+
+	table.Render()
+}
+
+func (f *Formatter) outputResourcesCSV(resources []api.Resource) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	writer.Write([]string{"Type", "ID", "URL"})
+
+	for _, resource := range resources {
+		writer.Write([]string{
+			resource.Type,
+			strconv.FormatInt(resource.ID, 10),
+			resource.URL,
+		})
+	}
+}
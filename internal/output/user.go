@@ -0,0 +1,122 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/felipemarinho97/deezer-cli/internal/api"
+	"github.com/olekukonko/tablewriter"
+)
+
+func (f *Formatter) FormatUsers(users []api.User) {
+	if len(users) == 0 {
+		fmt.Println("No users found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(users)
+	case "csv":
+		f.outputUsersCSV(users)
+	case "yaml":
+		f.outputYAML(users)
+	case "ids":
+		for _, user := range users {
+			fmt.Println(user.ID)
+		}
+	default:
+		f.outputUsersTable(users)
+	}
+}
+
+func (f *Formatter) FormatUser(user *api.User) {
+	if user == nil {
+		fmt.Println("User not found")
+		return
+	}
+
+	switch f.format {
+	case "json":
+		f.outputJSON(user)
+	case "yaml":
+		f.outputYAML(user)
+	case "ids":
+		fmt.Println(user.ID)
+	default:
+		f.outputUserDetail(user)
+	}
+}
+
+func (f *Formatter) outputUsersTable(users []api.User) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Name", "Link"})
+	table.SetBorder(true)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("│")
+	table.SetColumnSeparator("│")
+	table.SetRowSeparator("─")
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+		tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor},
+	)
+
+	for _, user := range users {
+		table.Append([]string{
+			strconv.FormatInt(user.ID, 10),
+			truncate(user.Name, 30),
+			user.Link,
+		})
+	}
+
+	table.Render()
+}
+
+func (f *Formatter) outputUsersCSV(users []api.User) {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	writer.Write([]string{"ID", "Name", "Link", "Picture", "Tracklist", "Country"})
+
+	for _, user := range users {
+		writer.Write([]string{
+			strconv.FormatInt(user.ID, 10),
+			user.Name,
+			user.Link,
+			user.Picture,
+			user.Tracklist,
+			user.Country,
+		})
+	}
+}
+
+func (f *Formatter) outputUserDetail(user *api.User) {
+	bold := color.New(color.Bold)
+	blue := color.New(color.FgBlue)
+
+	bold.Println("User Details")
+	fmt.Println(strings.Repeat("─", 50))
+
+	blue.Print("ID: ")
+	fmt.Println(user.ID)
+
+	blue.Print("Name: ")
+	fmt.Println(user.Name)
+
+	blue.Print("Country: ")
+	fmt.Println(user.Country)
+
+	blue.Print("Picture: ")
+	fmt.Println(user.PictureBig)
+
+	blue.Print("Link: ")
+	fmt.Println(user.Link)
+
+	blue.Print("Tracklist: ")
+	fmt.Println(user.Tracklist)
+}
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Deezer allows 50 requests per 5 seconds per client.
+const (
+	DefaultRequests = 50
+	DefaultPeriod   = 5 * time.Second
+)
+
+type Limiter interface {
+	// Wait blocks until a request may be made or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// window is a log of the start times of recent requests, oldest first.
+type window struct {
+	times []time.Time
+}
+
+// take drops requests that started more than period ago and records one at
+// now when fewer than requests remain. Otherwise it returns how long to
+// wait until the oldest request leaves the window.
+func (w *window) take(now time.Time, requests int, period time.Duration) time.Duration {
+	cutoff := now.Add(-period)
+	expired := 0
+	for expired < len(w.times) && !w.times[expired].After(cutoff) {
+		expired++
+	}
+	w.times = w.times[expired:]
+
+	if len(w.times) < requests {
+		w.times = append(w.times, now)
+		return 0
+	}
+
+	return w.times[len(w.times)-requests].Add(period).Sub(now)
+}
+
+// SlidingWindow allows at most requests in any span of period, safe for
+// concurrent use within a process.
+type SlidingWindow struct {
+	mu       sync.Mutex
+	requests int
+	period   time.Duration
+	window   window
+}
+
+// New returns a limiter allowing at most requests per period.
+func New(requests int, period time.Duration) *SlidingWindow {
+	return &SlidingWindow{
+		requests: requests,
+		period:   period,
+	}
+}
+
+func (s *SlidingWindow) Wait(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		delay := s.window.take(time.Now(), s.requests, s.period)
+		s.mu.Unlock()
+
+		if delay == 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWindowTake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		requests int
+		period   time.Duration
+		// offsets are the times of previous calls to take, relative to start.
+		offsets []time.Duration
+		at      time.Duration
+		want    time.Duration
+	}{
+		{"empty window", 3, time.Second, nil, 0, 0},
+		{"below budget", 3, time.Second, []time.Duration{0, 100 * time.Millisecond}, 200 * time.Millisecond, 0},
+		{"budget exhausted", 3, time.Second, []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond}, 300 * time.Millisecond, 700 * time.Millisecond},
+		{"oldest expired", 3, time.Second, []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond}, time.Second, 0},
+		{"just before expiry", 2, time.Second, []time.Duration{0, 500 * time.Millisecond}, 999 * time.Millisecond, time.Millisecond},
+		{"single request", 1, 5 * time.Second, []time.Duration{time.Second}, 2 * time.Second, 4 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w window
+			for _, offset := range tt.offsets {
+				if delay := w.take(start.Add(offset), tt.requests, tt.period); delay != 0 {
+					t.Fatalf("setup take at %v: got delay %v", offset, delay)
+				}
+			}
+
+			if got := w.take(start.Add(tt.at), tt.requests, tt.period); got != tt.want {
+				t.Errorf("take() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindowNeverExceedsBudget(t *testing.T) {
+	const requests = 50
+	period := 5 * time.Second
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Simulate a caller that retries as soon as it is allowed to and check
+	// every span of period along the way.
+	var w window
+	var sent []time.Time
+	now := start
+	for len(sent) < 4*requests {
+		delay := w.take(now, requests, period)
+		if delay == 0 {
+			sent = append(sent, now)
+			now = now.Add(time.Millisecond)
+			continue
+		}
+		now = now.Add(delay)
+	}
+
+	for i := range sent {
+		in := 0
+		for _, at := range sent[i:] {
+			if at.Sub(sent[i]) < period {
+				in++
+			}
+		}
+		if in > requests {
+			t.Fatalf("%d requests within %v starting at %v, want at most %d", in, period, sent[i].Sub(start), requests)
+		}
+	}
+}
+
+func TestSlidingWindowWaitCanceled(t *testing.T) {
+	limiter := New(1, time.Hour)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait on canceled context = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSharedBudgetAcrossLimiters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.state")
+
+	first, err := NewShared(path, 2, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewShared(path, 2, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, limiter := range []*Shared{first, second} {
+		delay, err := limiter.take()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if delay != 0 {
+			t.Fatalf("take within budget: got delay %v", delay)
+		}
+	}
+
+	delay, err := first.take()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delay <= 0 {
+		t.Errorf("take after the shared budget is spent: got delay %v, want > 0", delay)
+	}
+}
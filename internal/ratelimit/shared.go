@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/felipemarinho97/deezer-cli/internal/filelock"
+)
+
+// Shared is a sliding window whose request log lives in a file guarded by
+// a file lock, so that every process using the same file draws from one
+// budget.
+type Shared struct {
+	mu       sync.Mutex
+	path     string
+	requests int
+	period   time.Duration
+}
+
+type sharedState struct {
+	Times []time.Time `json:"times"`
+}
+
+func NewShared(path string, requests int, period time.Duration) (*Shared, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create rate limit directory: %w", err)
+	}
+
+	return &Shared{
+		path:     path,
+		requests: requests,
+		period:   period,
+	}, nil
+}
+
+func (s *Shared) Wait(ctx context.Context) error {
+	for {
+		delay, err := s.take()
+		if err != nil {
+			return err
+		}
+
+		if delay == 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Shared) take() (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open rate limit state: %w", err)
+	}
+	defer file.Close()
+
+	unlock, err := filelock.Lock(file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock rate limit state: %w", err)
+	}
+	defer unlock()
+
+	var state sharedState
+	if data, err := io.ReadAll(file); err == nil && len(data) > 0 {
+		// A corrupt state file simply starts over with an empty window.
+		json.Unmarshal(data, &state)
+	}
+
+	w := window{times: state.Times}
+	delay := w.take(time.Now(), s.requests, s.period)
+
+	data, err := json.Marshal(sharedState{Times: w.times})
+	if err != nil {
+		return 0, err
+	}
+	if err := file.Truncate(0); err != nil {
+		return 0, err
+	}
+	if _, err := file.WriteAt(data, 0); err != nil {
+		return 0, err
+	}
+
+	return delay, nil
+}